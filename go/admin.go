@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ControlRequest carries an admin request on to a node hosted by another
+// process: the clock as the admin left it, or an exposure in that node.
+type ControlRequest struct {
+	Clock    *ClockStatus `json:"clock,omitempty"`
+	Exposure *Exposure    `json:"exposure,omitempty"`
+}
+
+func (b *Body) RegisterAdminEndpoints(ctx context.Context, mux *http.ServeMux) {
+	mux.HandleFunc(ADMIN_SCENARIO_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		b.HandleScenarioRequest(ctx, w, r)
+	})
+	mux.HandleFunc(ADMIN_VACCINATE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		b.HandleExposureRequest(ctx, VACCINATE_ACTION, w, r)
+	})
+	mux.HandleFunc(ADMIN_IMMUNIZE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		b.HandleExposureRequest(ctx, IMMUNIZE_ACTION, w, r)
+	})
+	mux.HandleFunc(ADMIN_CLOCK_ENDPOINT, b.HandleClockRequest)
+	mux.HandleFunc(ADMIN_SNAPSHOT_ENDPOINT, b.HandleSnapshotRequest)
+	mux.HandleFunc(ADMIN_LINEAGE_ENDPOINT, b.lineage.HandleLineageRequest)
+	mux.HandleFunc(ADMIN_EDGES_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		b.HandleEdgesRequest(ctx, w, r)
+	})
+}
+
+// Control applies an admin request forwarded by the process the admin reached.
+func (b *Body) Control(ctx context.Context, request *ControlRequest) error {
+	if request.Clock != nil {
+		if err := b.clock.Sync(*request.Clock); err != nil {
+			return err
+		}
+	}
+	if request.Exposure != nil {
+		exposure := *request.Exposure
+		if b.FindNode(exposure.Node) == nil {
+			return fmt.Errorf("exposure %v: node is not hosted here", exposure)
+		}
+		scenario := &Scenario{Exposures: []Exposure{exposure}}
+		err := scenario.Validate()
+		if err == nil {
+			err = b.RunScenario(ctx, scenario)
+		}
+		return err
+	}
+	return nil
+}
+
+// forward sends a control request to the process hosting a remote node, at
+// the address the node announced to the directory.
+func (b *Body) forward(name string, request *ControlRequest) error {
+	if b.directory == nil {
+		return &RemoteNodeError{Node: name, Err: fmt.Errorf("there is no registry")}
+	}
+	listing, err := b.directory.Listing()
+	if err != nil {
+		return &RemoteNodeError{Node: name, Err: err}
+	}
+	address, ok := listing.Nodes[name]
+	if !ok {
+		return &RemoteNodeError{Node: name, Err: fmt.Errorf("it hasn't announced itself")}
+	}
+	if err := b.transport.SendControl(address, request); err != nil {
+		return &RemoteNodeError{Node: name, Err: err}
+	}
+	return nil
+}
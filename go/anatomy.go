@@ -0,0 +1,236 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+//go:embed bodies/human.json
+var defaultAnatomy []byte
+
+// Anatomy describes a body declaratively: the nodes, the organ each node
+// belongs to, the edges between them and the cells seeded into each node.
+type Anatomy struct {
+	Materials   *MaterialSeed    `json:"materials"`
+	Nodes       []NodeSpec       `json:"nodes"`
+	Edges       []EdgeSpec       `json:"edges"`
+	Repertoires []RepertoireSpec `json:"repertoires"`
+}
+
+type NodeSpec struct {
+	Name      string        `json:"name"`
+	Organ     string        `json:"organ"`
+	Verbose   bool          `json:"verbose"`
+	Materials *MaterialSeed `json:"materials"` // Overrides the anatomy's materials.
+	Cells     []CellSpec    `json:"cells"`
+}
+
+type CellSpec struct {
+	CellType string `json:"cellType"`
+	WorkType string `json:"workType"`
+	Name     string `json:"name"` // DNA name, defaults to HUMAN_NAME.
+	Count    int    `json:"count"`
+}
+
+// Edges mirror ConnectNodes: node1 reaches node2 through toNode2, and node2
+// reaches node1 through toNode1.
+type EdgeSpec struct {
+	Node1   string   `json:"node1"`
+	Node2   string   `json:"node2"`
+	ToNode2 EdgeType `json:"toNode2"`
+	ToNode1 EdgeType `json:"toNode1"`
+}
+
+// Lymphocytes with a randomized MHC II receptor set, spread round robin across
+// every node of an organ.
+type RepertoireSpec struct {
+	CellType   string `json:"cellType"`
+	Organ      string `json:"organ"`
+	Groups     int    `json:"groups"`
+	Redundancy int    `json:"redundancy"`
+}
+
+type MaterialSeed struct {
+	O2             int `json:"o2"`
+	Glucose        int `json:"glucose"`
+	Vitamins       int `json:"vitamins"`
+	CO2            int `json:"co2"`
+	Creatinine     int `json:"creatinine"`
+	Growth         int `json:"growth"`
+	Hunger         int `json:"hunger"`
+	Asphyxia       int `json:"asphyxia"`
+	Inflammation   int `json:"inflammation"`
+	GranulocyteCSF int `json:"granulocyteCsf"`
+	MacrophageCSF  int `json:"macrophageCsf"`
+	Interleukin3   int `json:"interleukin3"`
+	Interleukin2   int `json:"interleukin2"`
+	Ammonia        int `json:"ammonia"`
+	Glycogen       int `json:"glycogen"`
+	Complement     int `json:"complement"`
+	Insulin        int `json:"insulin"`
+	Glucagon       int `json:"glucagon"`
+	Interleukin10  int `json:"interleukin10"`
+}
+
+var edgeTypeNames = map[EdgeType]string{
+	cardiovascular:      "cardiovascular",
+	neuronal:            "neuronal",
+	lymphatic:           "lymphatic",
+	muscular:            "muscular",
+	skeletal:            "skeletal",
+	gut_lining:          "gut_lining",
+	blood_brain_barrier: "blood_brain_barrier",
+	thymic:              "thymic",
+	splenic:             "splenic",
+}
+
+func (e EdgeType) String() string {
+	name, ok := edgeTypeNames[e]
+	if !ok {
+		return fmt.Sprintf("EdgeType(%d)", int(e))
+	}
+	return name
+}
+
+func (e EdgeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+func (e *EdgeType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for edgeType, n := range edgeTypeNames {
+		if n == name {
+			*e = edgeType
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown edge type: %q", name)
+}
+
+func ParseCellType(name string) (CellType, error) {
+	cellType, ok := CellType_value[name]
+	if _, registered := cellTypes[CellType(cellType)]; !ok || !registered {
+		return CellType_CellTypeUnknown, fmt.Errorf("unknown cell type: %q", name)
+	}
+	return CellType(cellType), nil
+}
+
+func ParseWorkType(name string) (WorkType, error) {
+	if name == "" {
+		return WorkType_nothing, nil
+	}
+	workType, ok := WorkType_value[name]
+	if !ok {
+		return WorkType_nothing, fmt.Errorf("unknown work type: %q", name)
+	}
+	return WorkType(workType), nil
+}
+
+func ParseAnatomy(data []byte) (*Anatomy, error) {
+	anatomy := &Anatomy{}
+	err := json.Unmarshal(data, anatomy)
+	if err != nil {
+		return nil, fmt.Errorf("anatomy: %w", err)
+	}
+	err = anatomy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("anatomy: %w", err)
+	}
+	return anatomy, nil
+}
+
+func LoadAnatomy(path string) (*Anatomy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("anatomy: %w", err)
+	}
+	return ParseAnatomy(data)
+}
+
+func DefaultAnatomy() *Anatomy {
+	anatomy, err := ParseAnatomy(defaultAnatomy)
+	if err != nil {
+		panic(err)
+	}
+	return anatomy
+}
+
+func (a *Anatomy) Validate() error {
+	names := map[string]bool{}
+	organs := map[string]bool{}
+	for _, node := range a.Nodes {
+		if node.Name == "" {
+			return fmt.Errorf("node is missing a name")
+		}
+		if names[node.Name] {
+			return fmt.Errorf("duplicate node: %q", node.Name)
+		}
+		names[node.Name] = true
+		if !IsOrgan(node.Organ) {
+			return fmt.Errorf("node %q has unknown organ: %q", node.Name, node.Organ)
+		}
+		organs[node.Organ] = true
+		for _, cell := range node.Cells {
+			if _, err := ParseCellType(cell.CellType); err != nil {
+				return fmt.Errorf("node %q: %w", node.Name, err)
+			}
+			if _, err := ParseWorkType(cell.WorkType); err != nil {
+				return fmt.Errorf("node %q: %w", node.Name, err)
+			}
+			if cell.Count < 0 {
+				return fmt.Errorf("node %q: negative count of %v: %v", node.Name, cell.CellType, cell.Count)
+			}
+		}
+	}
+	for _, edge := range a.Edges {
+		if !names[edge.Node1] {
+			return fmt.Errorf("edge references unknown node: %q", edge.Node1)
+		}
+		if !names[edge.Node2] {
+			return fmt.Errorf("edge references unknown node: %q", edge.Node2)
+		}
+	}
+	for _, repertoire := range a.Repertoires {
+		if _, err := ParseCellType(repertoire.CellType); err != nil {
+			return fmt.Errorf("repertoire: %w", err)
+		}
+		if !organs[repertoire.Organ] {
+			return fmt.Errorf("repertoire %v has no %q nodes", repertoire.CellType, repertoire.Organ)
+		}
+		if repertoire.Groups <= 0 {
+			return fmt.Errorf("repertoire %v needs at least one group, got: %v", repertoire.CellType, repertoire.Groups)
+		}
+		if repertoire.Redundancy < 0 {
+			return fmt.Errorf("repertoire %v has negative redundancy: %v", repertoire.CellType, repertoire.Redundancy)
+		}
+	}
+	return nil
+}
+
+func (a *Anatomy) OrganNodeNames(organ string) (names []string) {
+	for _, node := range a.Nodes {
+		if node.Organ == organ {
+			names = append(names, node.Name)
+		}
+	}
+	return
+}
+
+func DefaultMaterialSeed() *MaterialSeed {
+	return &MaterialSeed{
+		O2:             SEED_O2,
+		Glucose:        SEED_GLUCOSE,
+		Vitamins:       SEED_VITAMINS,
+		Growth:         SEED_GROWTH,
+		GranulocyteCSF: SEED_GRANULOCYTE_COLONY_STIMULATING_FACTOR,
+		MacrophageCSF:  SEED_MACROPHAGE_COLONY_STIMULATING_FACTOR,
+		Interleukin3:   SEED_INTERLEUKIN_3,
+		Interleukin2:   SEED_INTERLEUKIN_2,
+		Interleukin10:  SEED_INTERLEUKIN_10,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -14,21 +15,33 @@ type AntigenPool struct {
 	antibodyLoads  *sync.Map
 	proteinChan    chan Protein
 	infectablePool *sync.Pool
+	mucosal        int32 // Whether IgA secreted across the gut lining acts here.
+	rand           *rand.Rand
+	clock          *Clock
 }
 
-func InitializeAntigenPool(ctx context.Context) *AntigenPool {
+// Antibody loads of each isotype are kept apart.
+type antibodyKey struct {
+	targetProtein Protein
+	isotype       AntibodyIsotype
+}
+
+func InitializeAntigenPool(ctx context.Context, random *rand.Rand, clock *Clock) *AntigenPool {
 	antigenPool := &AntigenPool{
 		viralLoads:     &sync.Map{},
 		antibodyLoads:  &sync.Map{},
 		proteinChan:    make(chan Protein, PROTEIN_CHAN_BUFFER),
 		infectablePool: &sync.Pool{},
+		rand:           random,
+		clock:          clock,
 	}
 	go antigenPool.Start(ctx)
 	return antigenPool
 }
 
 func (a *AntigenPool) Start(ctx context.Context) {
-	ticker := time.NewTicker(ANTIGEN_POOL_TICK_RATE)
+	ticker := a.clock.NewTicker(ctx, ANTIGEN_POOL_TICK_RATE)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
@@ -43,11 +56,14 @@ func (a *AntigenPool) Tick() {
 	// Pick a victim to infect or attach antibodies.
 	infectable := a.infectablePool.Get()
 	var antibodyLoads []*AntibodyLoad
-	a.antibodyLoads.Range(func(_, a any) bool {
-		antibodyLoad := a.(*AntibodyLoad)
+	a.antibodyLoads.Range(func(_, l any) bool {
+		antibodyLoad := l.(*AntibodyLoad)
+		if !a.Acts(antibodyLoad) {
+			return true
+		}
 		if infectable != nil {
 			c := infectable.(CellActor)
-			if antibodyLoad.ShouldAttach(c) {
+			if antibodyLoad.ShouldAttach(c, a.rand) {
 				antibodyLoad.Attach(c)
 			}
 		}
@@ -67,6 +83,32 @@ func (a *AntigenPool) Tick() {
 	})
 }
 
+// Acts is whether the antibodies bind anything in this pool. IgA is secreted
+// across the gut lining, so elsewhere it only passes through.
+func (a *AntigenPool) Acts(antibodyLoad *AntibodyLoad) bool {
+	return antibodyLoad.isotype != AntibodyIsotype_IgA || atomic.LoadInt32(&a.mucosal) != 0
+}
+
+func (a *AntigenPool) SetMucosal(mucosal bool) {
+	value := int32(0)
+	if mucosal {
+		value = 1
+	}
+	atomic.StoreInt32(&a.mucosal, value)
+}
+
+func (a *AntigenPool) Snapshot(dnas *DNAIndex) (viralLoads []*ViralLoadSnapshot, antibodyLoads []*AntibodyLoadSnapshot) {
+	a.viralLoads.Range(func(_, v any) bool {
+		viralLoads = append(viralLoads, v.(*ViralLoad).Snapshot(dnas))
+		return true
+	})
+	a.antibodyLoads.Range(func(_, l any) bool {
+		antibodyLoads = append(antibodyLoads, l.(*AntibodyLoad).Snapshot())
+		return true
+	})
+	return
+}
+
 func (a *AntigenPool) BroadcastExistence(c CellActor) {
 	a.infectablePool.Put(c)
 }
@@ -80,19 +122,30 @@ func (a *AntigenPool) DepositViralLoad(v *ViralLoad) {
 }
 
 func (a *AntigenPool) DepositAntibodyLoad(l *AntibodyLoad) {
-	antibodyLoad, _ := a.antibodyLoads.LoadOrStore(l.targetProtein, &AntibodyLoad{
+	antibodyLoad, _ := a.antibodyLoads.LoadOrStore(antibodyKey{l.targetProtein, l.isotype}, &AntibodyLoad{
 		targetProtein: l.targetProtein,
+		isotype:       l.isotype,
 		concentration: 0,
 	})
 	antibodyLoad.(*AntibodyLoad).Merge(l)
 }
 
-func (a *AntigenPool) DepositProteins(proteins []Protein) {
+// DepositProteins blocks while the pool catches up, and gives up once ctx is
+// done, returning false.
+func (a *AntigenPool) DepositProteins(ctx context.Context, proteins []Protein) bool {
 	for i := 0; i < PROTEIN_DEPOSIT_RATE; i++ {
+		if ctx.Err() != nil {
+			return false
+		}
 		for _, protein := range proteins {
-			a.proteinChan <- protein
+			select {
+			case a.proteinChan <- protein:
+			case <-ctx.Done():
+				return false
+			}
 		}
 	}
+	return true
 }
 
 func (a *AntigenPool) GetAntibodyLoad() int {
@@ -127,11 +180,11 @@ func (a *AntigenPool) GetViralLoad() int {
 	return viralLoadTotal
 }
 
-func (a *AntigenPool) SampleVirusProteins(sampleRate int64) (proteins []Protein) {
+func (a *AntigenPool) SampleVirusProteins(random *rand.Rand, sampleRate int64) (proteins []Protein) {
 	a.viralLoads.Range(func(_, v any) bool {
 		viralLoad := v.(*ViralLoad)
 		viralLoad.Lock()
-		if viralLoad.concentration > 0 && viralLoad.GetInfectionOddsResult() {
+		if viralLoad.concentration > 0 && viralLoad.GetInfectionOddsResult(random) {
 			proteins = append(proteins, viralLoad.virus.dna.selfProteins...)
 			if viralLoad.concentration > sampleRate {
 				viralLoad.concentration -= sampleRate
@@ -145,6 +198,38 @@ func (a *AntigenPool) SampleVirusProteins(sampleRate int64) (proteins []Protein)
 	return
 }
 
+// FilterOpsonizedViralLoad removes up to rate of each viral load that
+// opsonizing antibodies mark. Unlike neutralizing, filtering doesn't use the
+// antibodies up, they only flag the virus to the macrophages doing it.
+func (a *AntigenPool) FilterOpsonizedViralLoad(rate int64) (filtered int64) {
+	var antibodyLoads []*AntibodyLoad
+	a.antibodyLoads.Range(func(_, l any) bool {
+		antibodyLoads = append(antibodyLoads, l.(*AntibodyLoad))
+		return true
+	})
+	a.viralLoads.Range(func(_, v any) bool {
+		viralLoad := v.(*ViralLoad)
+		viralLoad.Lock()
+		defer viralLoad.Unlock()
+		for _, antibodyLoad := range antibodyLoads {
+			antibodyLoad.RLock()
+			marked := antibodyLoad.concentration > 0 && antibodyLoad.isotype.Opsonizes() && antibodyLoad.Recognizes(viralLoad.virus)
+			antibodyLoad.RUnlock()
+			if marked {
+				amount := rate
+				if amount > viralLoad.concentration {
+					amount = viralLoad.concentration
+				}
+				viralLoad.concentration -= amount
+				filtered += amount
+				break
+			}
+		}
+		return true
+	})
+	return
+}
+
 func (a *AntigenPool) GetExcessViralLoad() (viralLoads []*ViralLoad) {
 	a.viralLoads.Range(func(_, v any) bool {
 		viralLoad := v.(*ViralLoad)
@@ -179,6 +264,8 @@ func (a *AntigenPool) SampleProteins(ctx context.Context, sampleDuration time.Du
 func (a *AntigenPool) GetDiffusionLoad() *AntigenBlobSocketData {
 	var antibodyProteins []int32
 	var antibodyConcentrations []int64
+	var antibodyIsotypes []AntibodyIsotype
+	var antibodyAffinities []int32
 	a.antibodyLoads.Range(func(_, a any) bool {
 		antibodyLoad := a.(*AntibodyLoad)
 		antibodyLoad.Lock()
@@ -186,6 +273,8 @@ func (a *AntigenPool) GetDiffusionLoad() *AntigenBlobSocketData {
 			antibodyLoad.concentration /= 2
 			antibodyProteins = append(antibodyProteins, int32(antibodyLoad.targetProtein))
 			antibodyConcentrations = append(antibodyConcentrations, antibodyLoad.concentration)
+			antibodyIsotypes = append(antibodyIsotypes, antibodyLoad.isotype)
+			antibodyAffinities = append(antibodyAffinities, antibodyLoad.affinity)
 		}
 		antibodyLoad.Unlock()
 		return true
@@ -193,29 +282,106 @@ func (a *AntigenPool) GetDiffusionLoad() *AntigenBlobSocketData {
 	return &AntigenBlobSocketData{
 		AntibodyProteins:       antibodyProteins,
 		AntibodyConcentrations: antibodyConcentrations,
+		AntibodyIsotypes:       antibodyIsotypes,
+		AntibodyAffinities:     antibodyAffinities,
 	}
 }
 
 func (a *AntigenPool) PutDiffusionLoad(d *AntigenBlobSocketData) {
-	for i, antibodyProtein := range d.AntibodyProteins {
-		concentration := int64(0)
-		if i < len(d.AntibodyConcentrations) {
-			concentration = d.AntibodyConcentrations[i]
-		}
-		a.DepositAntibodyLoad(&AntibodyLoad{
+	concentrations, isotypes, affinities := d.GetAntibodyConcentrations(), d.GetAntibodyIsotypes(), d.GetAntibodyAffinities()
+	for i, antibodyProtein := range d.GetAntibodyProteins() {
+		antibodyLoad := &AntibodyLoad{
 			targetProtein: Protein(antibodyProtein),
-			concentration: concentration,
-		})
+			affinity:      ANTIBODY_BASE_AFFINITY, // Unless a newer node sent it.
+		}
+		if i < len(concentrations) {
+			antibodyLoad.concentration = concentrations[i]
+		}
+		if i < len(isotypes) {
+			antibodyLoad.isotype = isotypes[i]
+		}
+		if i < len(affinities) {
+			antibodyLoad.affinity = affinities[i]
+		}
+		a.DepositAntibodyLoad(antibodyLoad)
+	}
+}
+
+// An InactivatedAntigen carries a pathogen's proteins, but no state diagram
+// to run, so it can neither divide nor infect. Antigen presenting cells
+// sample it like the remains of a dead pathogen.
+type InactivatedAntigen struct {
+	dna  *DNA
+	dose int
+}
+
+// Deliver deposits the antigen into the node's pool until ctx is done, and
+// flags an injection site in its tissue for antigen presenting cells to gather
+// at. An adjuvant inflames the node, so that monocytes passing through stay and
+// differentiate.
+func (v *InactivatedAntigen) Deliver(ctx context.Context, n *Node) {
+	go func() {
+		for i := 0; i < v.dose; i++ {
+			if !n.antigenPool.DepositProteins(ctx, v.dna.selfProteins) {
+				return
+			}
+		}
+	}()
+	n.materialPool.PutLigand(&LigandBlob{
+		inflammation: VACCINE_ADJUVANT_INFLAMMATION,
+	})
+	if n.tissue != nil && n.tissue.rootMatrix != nil {
+		site := n.tissue.rootMatrix.RandomOpenPoint(n.exposureRand)
+		n.tissue.rootMatrix.AddCytokine(site, CytokineType_antigen_present, CYTOKINE_ANTIGEN_PRESENT)
 	}
 }
 
+// IgE arms the cells that kill what it marks, rather than neutralizing.
+func (i AntibodyIsotype) Neutralizes() bool {
+	return i != AntibodyIsotype_IgE
+}
+
+// IgM fixes complement and IgG binds phagocytes directly, so both flag what
+// they bind to be engulfed.
+func (i AntibodyIsotype) Opsonizes() bool {
+	return i == AntibodyIsotype_IgM || i == AntibodyIsotype_IgG
+}
+
 type AntibodyLoad struct {
 	sync.RWMutex
 	targetProtein Protein
+	isotype       AntibodyIsotype
+	affinity      int32 // Out of ANTIBODY_MAX_AFFINITY, averaged over the load.
 	concentration int64
 }
 
-func (a *AntibodyLoad) ShouldAttach(antigenPresentor AntigenPresenting) bool {
+func (a *AntibodyLoad) Snapshot() *AntibodyLoadSnapshot {
+	a.RLock()
+	defer a.RUnlock()
+	return &AntibodyLoadSnapshot{
+		TargetProtein: uint32(a.targetProtein),
+		Concentration: a.concentration,
+		Isotype:       a.isotype,
+		Affinity:      a.affinity,
+	}
+}
+
+// RestoreAntibodyLoad gives loads saved before antibodies had affinities the
+// affinity of a naive B cell's antibody, as Immunoglobulin.Restore does.
+func RestoreAntibodyLoad(snapshot *AntibodyLoadSnapshot) *AntibodyLoad {
+	affinity := snapshot.Affinity
+	if affinity == 0 {
+		affinity = ANTIBODY_BASE_AFFINITY
+	}
+	return &AntibodyLoad{
+		targetProtein: Protein(snapshot.TargetProtein),
+		isotype:       snapshot.Isotype,
+		affinity:      affinity,
+		concentration: snapshot.Concentration,
+	}
+}
+
+func (a *AntibodyLoad) Recognizes(antigenPresentor AntigenPresenting) bool {
 	antigen := antigenPresentor.PresentAntigen()
 	for _, protein := range antigen.proteins {
 		if protein == a.targetProtein {
@@ -225,18 +391,34 @@ func (a *AntibodyLoad) ShouldAttach(antigenPresentor AntigenPresenting) bool {
 	return false
 }
 
+// Low affinity antibodies come off the antigen about as often as they bind
+// it, so they are less likely to stay attached.
+func (a *AntibodyLoad) ShouldAttach(antigenPresentor AntigenPresenting, random *rand.Rand) bool {
+	return a.Recognizes(antigenPresentor) && random.Int31n(ANTIBODY_MAX_AFFINITY) < a.affinity
+}
+
 func (a *AntibodyLoad) Attach(cell CellActor) bool {
+	if a.Deplete(1) == 0 {
+		return false
+	}
 	cell.AddAntibodyLoad(&AntibodyLoad{
 		targetProtein: a.targetProtein,
+		isotype:       a.isotype,
+		affinity:      a.affinity,
 		concentration: 1,
 	})
-	a.Deplete(1)
 	return false
 }
 
+// Deplete binds up to amount of the antigen, scaled down by affinity, and
+// returns how many antibodies were used up doing so.
 func (a *AntibodyLoad) Deplete(amount int64) (depleted int64) {
 	a.Lock()
 	defer a.Unlock()
+	amount = amount * int64(a.affinity) / ANTIBODY_MAX_AFFINITY
+	if amount < 1 {
+		amount = 1
+	}
 	if amount > a.concentration {
 		depleted = a.concentration
 		a.concentration = 0
@@ -253,7 +435,11 @@ func (a *AntibodyLoad) Merge(antibodyLoad *AntibodyLoad) {
 	}
 	a.Lock()
 	defer a.Unlock()
-	a.concentration += antibodyLoad.concentration
+	concentration := a.concentration + antibodyLoad.concentration
+	if concentration > 0 {
+		a.affinity = int32((int64(a.affinity)*a.concentration + int64(antibodyLoad.affinity)*antibodyLoad.concentration) / concentration)
+	}
+	a.concentration = concentration
 }
 
 type ViralLoad struct {
@@ -262,15 +448,26 @@ type ViralLoad struct {
 	concentration int64
 }
 
-func (v *ViralLoad) GetInfectionOddsResult() bool {
+func (v *ViralLoad) GetInfectionOddsResult(random *rand.Rand) bool {
 	if v.concentration >= v.virus.infectivity*MAX_INFECTION_ODDS {
 		// Cap the odds.
-		return rand.Intn(MAX_INFECTION_ODDS) == 0
+		return random.Intn(MAX_INFECTION_ODDS) == 0
 	}
 	// Generate a random number within the infectivity range. If the generated
 	// number is less than or equal to the concentration, then the cell was
 	// infected.
-	return rand.Int63n(v.virus.infectivity) <= v.concentration
+	return random.Int63n(v.virus.infectivity) <= v.concentration
+}
+
+func (v *ViralLoad) Snapshot(dnas *DNAIndex) *ViralLoadSnapshot {
+	v.RLock()
+	defer v.RUnlock()
+	return &ViralLoadSnapshot{
+		Dna:            dnas.Add(v.virus.dna),
+		TargetCellType: v.virus.targetCellType,
+		Infectivity:    v.virus.infectivity,
+		Concentration:  v.concentration,
+	}
 }
 
 func (v *ViralLoad) ShouldInfect(cell CellActor) bool {
@@ -282,7 +479,7 @@ func (v *ViralLoad) ShouldInfect(cell CellActor) bool {
 		v.virus.infectivity <= 0 {
 		return false
 	}
-	return v.GetInfectionOddsResult()
+	return v.GetInfectionOddsResult(cell.Rand())
 }
 
 func (v *ViralLoad) Tick(antibodies []*AntibodyLoad) {
@@ -292,7 +489,7 @@ func (v *ViralLoad) Tick(antibodies []*AntibodyLoad) {
 		return
 	}
 	for _, antibody := range antibodies {
-		if antibody.concentration > 0 && antibody.ShouldAttach(v.virus) {
+		if antibody.concentration > 0 && antibody.isotype.Neutralizes() && antibody.Recognizes(v.virus) {
 			depleted := antibody.Deplete(v.concentration)
 			v.concentration -= depleted
 		}
@@ -333,23 +530,36 @@ func (v *Virus) DNA() *DNA {
 }
 
 func (v *Virus) Infect(c CellActor) {
-	if c.CellType() == v.targetCellType && c.ViralLoad() == nil {
-		c.AddViralLoad(&ViralLoad{
-			virus: v,
-		})
-		base := c.DNA()
-		proteins := append(base.selfProteins, v.dna.selfProteins...)
-		c.SetDNA(&DNA{
-			name:         base.name,
-			base:         base.base,
-			dnaType:      base.dnaType,
-			selfProteins: proteins,
-			makeFunction: base.makeFunction,
-		})
-		function := c.Function()
-		if function != nil {
-			function.Graft(v.dna.makeFunction(c, v.dna))
+	if v.Reinfect(c) {
+		c.LogEvent(Event{Type: INFECTION_EVENT, Target: v.dna.name})
+		if c.Organ() != nil {
+			c.Organ().lineage.Infect(c.Lineage().ID, v.dna.name)
 		}
-		fmt.Println("Virus: ", v.dna.name, "infected", c)
 	}
 }
+
+// Reinfect grafts the virus onto a cell without recording a new infection,
+// for cells that were infected before they were restored or transported.
+func (v *Virus) Reinfect(c CellActor) bool {
+	if c.CellType() != v.targetCellType || c.ViralLoad() != nil {
+		return false
+	}
+	c.AddViralLoad(&ViralLoad{
+		virus: v,
+	})
+	base := c.DNA()
+	proteins := append(base.selfProteins, v.dna.selfProteins...)
+	c.SetDNA(&DNA{
+		id:           base.id,
+		name:         base.name,
+		base:         base.base,
+		dnaType:      base.dnaType,
+		selfProteins: proteins,
+		makeFunction: base.makeFunction,
+	})
+	function := c.Function()
+	if function != nil {
+		function.Graft(v.dna.makeFunction(c, v.dna))
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestComplement(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(1000, 0), []*NodeSnapshot{
+		{Name: "Complement Blood", Organ: "blood", Materials: &MaterialStatusSocketData{Complement: 1000}},
+	})
+	node := body.FindNode("Complement Blood")
+	bacteria := MakeDNA(BACTERIA_DNA, "Complement Bacteria")
+	macrophage := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Macrophagocyte})
+	bacterium := body.restore(node, bacteria, &CellSnapshot{CellType: CellType_Bacteria})
+	macrophage.Interact(context.Background(), bacterium)
+	if bacterium.IsApoptosis() {
+		t.Fatal("Expected a resting macrophage to leave bare bacteria alone")
+	}
+	FixComplement(context.Background(), bacterium)
+	if bacterium.Opsonins() != COMPLEMENT_FIXATION {
+		t.Errorf("Expected complement to bind to the bacterium, got: %v", bacterium.Opsonins())
+	}
+	marked := body.restore(node, bacteria, &CellSnapshot{CellType: CellType_Bacteria})
+	marked.AddAntibodyLoad(&AntibodyLoad{targetProtein: bacteria.selfProteins[0], concentration: 1})
+	FixComplement(context.Background(), marked)
+	if marked.Opsonins() != 2*COMPLEMENT_FIXATION {
+		t.Errorf("Expected antibodies to speed up complement binding, got: %v", marked.Opsonins())
+	}
+	for bacterium.Opsonins() < COMPLEMENT_MEMBRANE_ATTACK_THRESHOLD {
+		FixComplement(context.Background(), bacterium)
+	}
+	if bacterium.Damage() == 0 {
+		t.Error("Expected membrane attack complexes to damage the bacterium")
+	}
+	macrophage.Interact(context.Background(), bacterium)
+	if !bacterium.IsApoptosis() {
+		t.Error("Expected an opsonized bacterium to be engulfed")
+	}
+}
+
+func TestAntibodyMaturation(t *testing.T) {
+	now := time.Unix(100000, 0)
+	body := restoreTestBody(t, now, []*NodeSnapshot{
+		{Name: "Maturation Lymph Node", Organ: "lymph"},
+		{Name: "Maturation Gut", Organ: "gut"},
+		{Name: "Maturation Heart", Organ: "heart"},
+	})
+	virus := MakeDNA(VIRUS_RNA, "Maturation Virus")
+	lymph := body.FindNode("Maturation Lymph Node")
+	gut := body.FindNode("Maturation Gut")
+	heart := body.FindNode("Maturation Heart")
+	antigen := virus.selfProteins[0]
+
+	memoryB := body.restore(lymph, body.human, &CellSnapshot{
+		CellType: CellType_MemoryBLymphocyte,
+		Isotype:  AntibodyIsotype_IgG,
+		Affinity: ANTIBODY_BASE_AFFINITY,
+	}, antigen).(*MemoryBCell)
+	immunoglobulin := memoryB.Immunoglobulin()
+	affinity := immunoglobulin.affinity
+	for i := 0; i < 100; i++ {
+		immunoglobulin.Mutate(memoryB.Rand(), now.Add(time.Duration(i)*SOMATIC_HYPERMUTATION_INTERVAL/2))
+		if immunoglobulin.affinity < affinity || immunoglobulin.affinity > ANTIBODY_MAX_AFFINITY {
+			t.Fatalf("Expected affinity to only rise up to the max, went from %v to %v", affinity, immunoglobulin.affinity)
+		}
+		affinity = immunoglobulin.affinity
+	}
+	if affinity <= ANTIBODY_BASE_AFFINITY {
+		t.Errorf("Expected somatic hypermutation to raise affinity, got: %v", affinity)
+	}
+	immunoglobulin.ClassSwitch(AntibodyIsotype_IgM)
+	if snapshot := memoryB.Snapshot(body.dnas); snapshot.Isotype != AntibodyIsotype_IgG || snapshot.Affinity != affinity {
+		t.Errorf("Expected the Memory B Cell to keep its IgG, got: %v with affinity %v", snapshot.Isotype, snapshot.Affinity)
+	}
+
+	// The first response switches and matures too: an activated B cell passes
+	// its isotype and affinity on, and its Effector B Cells keep refining them.
+	bCell := body.restore(lymph, body.human, &CellSnapshot{
+		CellType: CellType_BLymphocyte,
+		Isotype:  AntibodyIsotype_IgG,
+		Affinity: ANTIBODY_BASE_AFFINITY + 1,
+	}, antigen).(*BCell)
+	bCell.mhc_ii.SetPresented([]Protein{antigen})
+	// Through the cell, as its program divides it, rather than its Leukocyte.
+	bCell.Mitosis(context.Background())
+	var primary *EffectorBCell
+	for _, cell := range lymph.Cells() {
+		if effectorB, ok := cell.(*EffectorBCell); ok {
+			primary = effectorB
+		}
+	}
+	if primary == nil || primary.immunoglobulin.isotype != AntibodyIsotype_IgG || primary.immunoglobulin.affinity != ANTIBODY_BASE_AFFINITY+1 {
+		t.Fatalf("Expected an Effector B Cell making the B cell's IgG, got: %+v", primary)
+	}
+	if bCell.immunoglobulin.isotype != AntibodyIsotype_IgG {
+		t.Errorf("Expected the B cell not to switch back to IgM, got: %v", bCell.immunoglobulin.isotype)
+	}
+	for i := 0; i < 100; i++ {
+		body.clock.Set(now.Add(time.Duration(i) * SOMATIC_HYPERMUTATION_INTERVAL))
+		primary.DoWork(context.Background())
+	}
+	if primary.immunoglobulin.affinity <= ANTIBODY_BASE_AFFINITY+1 {
+		t.Errorf("Expected the Effector B Cell to mature in the lymph node, got: %v", primary.immunoglobulin.affinity)
+	}
+
+	// Antibodies saved before they had affinities have those of a naive B cell.
+	legacy := body.restore(heart, body.human, &CellSnapshot{
+		CellType:     CellType_Cardiomyocyte,
+		AntibodyLoad: &AntibodyLoadSnapshot{TargetProtein: uint32(antigen), Concentration: 5},
+	})
+	if legacy.AntibodyLoad().affinity != ANTIBODY_BASE_AFFINITY {
+		t.Errorf("Expected a legacy antibody load to get the base affinity, got: %v", legacy.AntibodyLoad().affinity)
+	}
+
+	// IgA only acts where it is secreted across the gut lining.
+	for _, node := range []*Node{gut, heart} {
+		effectorB := body.restore(node, body.human, &CellSnapshot{
+			CellType: CellType_EffectorBLymphocyte,
+			Isotype:  AntibodyIsotype_IgA,
+			Affinity: ANTIBODY_MAX_AFFINITY,
+		}, antigen)
+		effectorB.DoWork(context.Background())
+		node.antigenPool.DepositViralLoad(&ViralLoad{
+			virus:         &Virus{dna: virus, infectivity: 1},
+			concentration: EFFECTOR_BCELL_ANTIBODY_PRODUCTION,
+		})
+		node.antigenPool.Tick()
+	}
+	if viralLoad := gut.antigenPool.GetViralLoad(); viralLoad != 0 {
+		t.Errorf("Expected IgA to neutralize the virus in the gut, got: %v", viralLoad)
+	}
+	if viralLoad := heart.antigenPool.GetViralLoad(); viralLoad != EFFECTOR_BCELL_ANTIBODY_PRODUCTION {
+		t.Errorf("Expected IgA to pass through the heart, got: %v", viralLoad)
+	}
+
+	// Low affinity antibodies neutralize less of the virus at a time.
+	weak := &AntibodyLoad{targetProtein: antigen, affinity: ANTIBODY_MAX_AFFINITY / 4, concentration: 100}
+	if depleted := weak.Deplete(40); depleted != 10 {
+		t.Errorf("Expected a quarter of the virus to be bound, got: %v", depleted)
+	}
+	weak.Merge(&AntibodyLoad{targetProtein: antigen, affinity: ANTIBODY_MAX_AFFINITY, concentration: 90})
+	if weak.affinity != (ANTIBODY_MAX_AFFINITY/4+ANTIBODY_MAX_AFFINITY)/2 {
+		t.Errorf("Expected affinity to average over the load, got: %v", weak.affinity)
+	}
+}
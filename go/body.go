@@ -2,378 +2,268 @@ package main
 
 import (
 	"context"
-	"time"
+	"fmt"
 )
 
 type Graph struct {
-	allNodes map[string]*Node
+	allNodes  map[string]*Node
+	clock     *Clock
+	events    *EventLog
+	lineage   *LineageRegistry
+	transport Transporter
 }
 
 type Body struct {
 	*Graph
-	bloodNodes  []*Node
-	boneNodes   []*Node
-	brainNodes  []*Node
-	gutNodes    []*Node
-	heartNodes  []*Node
-	lymphNodes  []*Node
-	lungNodes   []*Node
-	muscleNodes []*Node
-	skinNodes   []*Node
-	kidneyNodes []*Node
+	bloodNodes    []*Node
+	boneNodes     []*Node
+	brainNodes    []*Node
+	gutNodes      []*Node
+	heartNodes    []*Node
+	lymphNodes    []*Node
+	lungNodes     []*Node
+	muscleNodes   []*Node
+	skinNodes     []*Node
+	kidneyNodes   []*Node
+	liverNodes    []*Node
+	thymusNodes   []*Node
+	spleenNodes   []*Node
+	pancreasNodes []*Node
+	pathogens     *PathogenRegistry
+	remote        map[string]bool // Nodes of the anatomy hosted by other processes.
+	directory     NodeDirectory
 }
 
-func (b *Body) GenerateCellsAndStart(ctx context.Context) {
+var ORGANS = []string{
+	"blood",
+	"bone",
+	"brain",
+	"gut",
+	"heart",
+	"lymph",
+	"lung",
+	"muscle",
+	"skin",
+	"kidney",
+	"liver",
+	"thymus",
+	"spleen",
+	"pancreas",
+}
 
-	// Generate Eukaryotic Cells
-	nodeTypes := [][]*Node{
-		b.bloodNodes,
-		b.brainNodes,
-		b.heartNodes,
-		b.lungNodes,
-		b.muscleNodes,
-		b.skinNodes,
-		b.gutNodes,
-		b.kidneyNodes,
-		b.boneNodes,
-	}
-	cellTypes := []CellType{
-		CellType_RedBlood,
-		CellType_Neuron,
-		CellType_Cardiomyocyte,
-		CellType_Pneumocyte,
-		CellType_Myocyte,
-		CellType_Keratinocyte,
-		CellType_Enterocyte,
-		CellType_Podocyte,
-		CellType_Hemocytoblast,
+func IsOrgan(organ string) bool {
+	for _, o := range ORGANS {
+		if o == organ {
+			return true
+		}
 	}
+	return false
+}
 
-	workTypes := []WorkType{
-		WorkType_exchange, // Blood
-		WorkType_think,    // Brain
-		WorkType_pump,     // Heart
-		WorkType_exhale,   // Lungs
-		WorkType_move,     // Muscles
-		WorkType_cover,    // Skin
-		WorkType_digest,   // Gut
-		WorkType_filter,   // Kidney
-		WorkType_nothing,  // BoneMarrow
+func (b *Body) OrganNodes(organ string) *[]*Node {
+	switch organ {
+	case "blood":
+		return &b.bloodNodes
+	case "bone":
+		return &b.boneNodes
+	case "brain":
+		return &b.brainNodes
+	case "gut":
+		return &b.gutNodes
+	case "heart":
+		return &b.heartNodes
+	case "lymph":
+		return &b.lymphNodes
+	case "lung":
+		return &b.lungNodes
+	case "muscle":
+		return &b.muscleNodes
+	case "skin":
+		return &b.skinNodes
+	case "kidney":
+		return &b.kidneyNodes
+	case "liver":
+		return &b.liverNodes
+	case "thymus":
+		return &b.thymusNodes
+	case "spleen":
+		return &b.spleenNodes
+	case "pancreas":
+		return &b.pancreasNodes
 	}
+	return nil
+}
 
-	counts := []int{
-		1, // Blood
-		3, // Brain
-		1, // Heart
-		1, // Lungs
-		1, // Muscles
-		1, // Skin
-		1, // Gut
-		1, // Kidney
-		1, // BoneMarrow
+func (g *Graph) FindNode(name string) *Node {
+	for _, node := range g.allNodes {
+		if node.name == name {
+			return node
+		}
 	}
+	return nil
+}
+
+func (b *Body) GenerateCellsAndStart(ctx context.Context, anatomy *Anatomy) {
 	humanDNA := MakeDNA(HUMAN_DNA, HUMAN_NAME)
-	for i, nodes := range nodeTypes {
-		for _, node := range nodes {
-			for j := 0; j < counts[i]; j++ {
-				MakeTransportRequest(node.transportUrl, HUMAN_NAME, humanDNA, cellTypes[i], workTypes[i], "", time.Now(), [10]string{}, [10]string{}, nil)
-				if cellTypes[i] == CellType_Neuron {
-					// Add a Hemocytoblast to the brain, to spawn immune cells.
-					MakeTransportRequest(node.transportUrl, HUMAN_NAME, humanDNA, CellType_Hemocytoblast, WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, nil)
+	for _, spec := range anatomy.Nodes {
+		node := b.FindNode(spec.Name)
+		if node == nil {
+			// Hosted elsewhere.
+			continue
+		}
+		// Bacteria of the same strain share DNA within a node.
+		strains := map[string]*DNA{}
+		for _, cell := range spec.Cells {
+			cellType, _ := ParseCellType(cell.CellType)
+			workType, _ := ParseWorkType(cell.WorkType)
+			name := cell.Name
+			dna := humanDNA
+			switch LookupCellType(cellType).DNAType {
+			case BACTERIA_DNA:
+				if _, ok := strains[name]; !ok {
+					strains[name] = MakeDNA(BACTERIA_DNA, name)
 				}
+				dna = strains[name]
+			default:
+				name = HUMAN_NAME
+			}
+			for i := 0; i < cell.Count; i++ {
+				node.MakeTransportRequest(node.transportUrl, name, dna, cellType, workType, "", b.clock.Now(), [10]string{}, [10]string{}, nil, Lineage{}, node.rand.Int63())
 			}
-		}
-	}
-	// Generate T Cells.
-	for i, mhc_ii := range humanDNA.Generate_MHCII_Groups(VIRGIN_TCELL_COUNT) {
-		node := b.lymphNodes[i%len(b.lymphNodes)]
-		for j := 0; j < VIRGIN_TCELL_REDUNDANCY; j++ {
-			MakeTransportRequest(node.transportUrl, HUMAN_NAME, humanDNA, CellType_VirginTLymphocyte, WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, mhc_ii)
 		}
 	}
 
-	// Generate B Cells.
-	for i, mhc_ii := range humanDNA.Generate_MHCII_Groups(BCELL_COUNT) {
-		node := b.boneNodes[i%len(b.boneNodes)]
-		for j := 0; j < BCELL_REDUNDANCY; j++ {
-			MakeTransportRequest(node.transportUrl, HUMAN_NAME, humanDNA, CellType_BLymphocyte, WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, mhc_ii)
+	// Generate T Cells and B Cells.
+	for _, repertoire := range anatomy.Repertoires {
+		cellType, _ := ParseCellType(repertoire.CellType)
+		// Spread over the organ's nodes in every process, so that each
+		// process generates its own share of the same repertoire.
+		names := anatomy.OrganNodeNames(repertoire.Organ)
+		for i, mhc_ii := range humanDNA.Generate_MHCII_Groups(repertoire.Groups) {
+			node := b.FindNode(names[i%len(names)])
+			if node == nil {
+				continue
+			}
+			for j := 0; j < repertoire.Redundancy; j++ {
+				node.MakeTransportRequest(node.transportUrl, HUMAN_NAME, humanDNA, cellType, WorkType_nothing, "", b.clock.Now(), [10]string{}, [10]string{}, mhc_ii, Lineage{}, node.rand.Int63())
+			}
 		}
 	}
+}
 
-	// Generate Prokaryotic Cells
-	nodeTypes = [][]*Node{
-		b.gutNodes,
-		b.gutNodes,
-		b.gutNodes,
-		b.gutNodes,
-		b.gutNodes,
-		b.gutNodes,
+func GenerateBody(ctx context.Context) *Body {
+	return GenerateBodyFromAnatomy(ctx, DefaultAnatomy(), InitializeHTTPTransport())
+}
+
+func GenerateBodyFromAnatomy(ctx context.Context, anatomy *Anatomy, transport Transporter) *Body {
+	b := InitializeBody(ctx, anatomy, transport)
+	b.GenerateCellsAndStart(ctx, anatomy)
+	return b
+}
+
+// InitializeBody builds the nodes and edges of an anatomy, without any cells.
+func InitializeBody(ctx context.Context, anatomy *Anatomy, transport Transporter) *Body {
+	b := makeBody(transport)
+	for _, spec := range anatomy.Nodes {
+		b.addNode(ctx, anatomy, spec)
 	}
-	cellTypes = []CellType{
-		CellType_Bacteroidota,
-		CellType_Bacteroidota,
-		CellType_Bacteroidota,
-		CellType_Bacteroidota,
-		CellType_Bacteroidota,
-		CellType_Bacteroidota,
+	for _, edge := range anatomy.Edges {
+		ConnectNodes(ctx, b.FindNode(edge.Node1), b.FindNode(edge.Node2), edge.ToNode2, edge.ToNode1)
 	}
-	counts = []int{
-		1,
-		1,
-		1,
-		1,
-		1,
-		1,
+	return b
+}
+
+// InitializeBodyPart builds only the hosted nodes of an anatomy, or all of
+// them if hosted is nil, and announces them to the directory. Edges to nodes
+// hosted elsewhere are dialed once they have announced themselves.
+func InitializeBodyPart(ctx context.Context, anatomy *Anatomy, transport Transporter, hosted map[string]bool, directory NodeDirectory) (*Body, error) {
+	names := map[string]bool{}
+	for _, spec := range anatomy.Nodes {
+		names[spec.Name] = true
 	}
-	names := []string{
-		"Adolescentis Animalis",
-		"Bifidum",
-		"Breve",
-		"Acidophilus",
-		"Johnsonii",
-		"Delbrueckii",
+	for name := range hosted {
+		if !names[name] {
+			return nil, fmt.Errorf("body: unknown node %q", name)
+		}
 	}
-
-	for i, nodes := range nodeTypes {
-		for _, node := range nodes {
-			bacteriaDNA := MakeDNA(BACTERIA_DNA, names[i])
-			for j := 0; j < counts[i]; j++ {
-				MakeTransportRequest(node.transportUrl, names[i], bacteriaDNA, cellTypes[i], WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, nil)
+	b := makeBody(transport)
+	b.remote = map[string]bool{}
+	b.directory = directory
+	for _, spec := range anatomy.Nodes {
+		if hosted != nil && !hosted[spec.Name] {
+			b.remote[spec.Name] = true
+			continue
+		}
+		node := b.addNode(ctx, anatomy, spec)
+		if directory != nil {
+			if err := directory.Announce(node.name, node.transportUrl); err != nil {
+				return nil, err
 			}
 		}
 	}
-	InfectBody(b)
+	for _, edge := range anatomy.Edges {
+		if err := b.ConnectByName(ctx, edge.Node1, edge.Node2, edge.ToNode2); err != nil {
+			return nil, err
+		}
+		if err := b.ConnectByName(ctx, edge.Node2, edge.Node1, edge.ToNode1); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
 }
 
-func InfectBody(b *Body) {
-	// Infection test.
-	node := b.lungNodes[0]
-	node.verbose = false
-	cellTypes := []CellType{
-		CellType_Bacteria,
-		CellType_Bacteria,
-		CellType_ViralLoadCarrier,
+// NodeAddress finds a node hosted here or, failing that, waits for it to be
+// announced to the directory.
+func (b *Body) NodeAddress(ctx context.Context, name string) (string, error) {
+	if node := b.FindNode(name); node != nil {
+		return node.transportUrl, nil
 	}
-	counts := []int{
-		0,
-		10,
-		0,
+	if b.directory == nil {
+		return "", fmt.Errorf("body: node %q is not hosted here and there is no registry", name)
 	}
-	names := []string{
-		"Clostridium tetani",
-		"Streptococcus pneumoniae",
-		"SARS-COV-2",
+	return LookupNode(ctx, b.directory, name)
+}
+
+// ConnectByName dials an edge from one node to another, if the first is
+// hosted here.
+func (b *Body) ConnectByName(ctx context.Context, from, to string, edgeType EdgeType) error {
+	node := b.FindNode(from)
+	if node == nil {
+		return nil
 	}
-	dna := []*DNA{
-		MakeDNA(BACTERIA_DNA, names[0]),
-		MakeDNA(BACTERIA_DNA, names[1]),
-		MakeVirusDNA(names[2], CellType_Pneumocyte),
-	}
-	for i, cellType := range cellTypes {
-		for j := 0; j < counts[i]; j++ {
-			MakeTransportRequest(node.transportUrl, names[i], dna[i], cellType, WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, nil)
-		}
+	transportUrl, err := b.NodeAddress(ctx, to)
+	if err != nil {
+		return err
 	}
+	return node.Connect(ctx, transportUrl, edgeType)
 }
 
-func GenerateBody(ctx context.Context) *Body {
-	b := &Body{
+func makeBody(transport Transporter) *Body {
+	return &Body{
 		Graph: &Graph{
-			allNodes: make(map[string]*Node),
+			allNodes:  make(map[string]*Node),
+			clock:     InitializeClock(),
+			events:    InitializeEventLog(),
+			lineage:   InitializeLineageRegistry(),
+			transport: transport,
 		},
+		pathogens: &PathogenRegistry{},
 	}
-	// Organs
-	brain := InitializeNewNode(ctx, b.Graph, "Brain", false)
-	b.brainNodes = append(b.brainNodes, brain)
-
-	heart := InitializeNewNode(ctx, b.Graph, "Heart", false)
-	b.heartNodes = append(b.heartNodes, heart)
-	ConnectNodes(ctx, heart, brain, neuronal, neuronal)
-
-	lungLeft := InitializeNewNode(ctx, b.Graph, "Left Lung", false)
-	lungRight := InitializeNewNode(ctx, b.Graph, "Right Lung", false)
-	ConnectNodes(ctx, lungLeft, heart, muscular, muscular)
-	ConnectNodes(ctx, lungRight, heart, muscular, muscular)
-	b.lungNodes = append(b.lungNodes, lungLeft, lungRight)
-
-	// Kidneys
-	kidneyLeft := InitializeNewNode(ctx, b.Graph, "Kidney - Left", false)
-	kidneyRight := InitializeNewNode(ctx, b.Graph, "Kidney - Right", false)
-	b.kidneyNodes = append(b.kidneyNodes, kidneyLeft, kidneyRight)
-
-	// Muscles and Skin
-
-	// Left Arm
-	muscleLeftArm := InitializeNewNode(ctx, b.Graph, "Left Arm Muscle", false)
-	skinLeftArm := InitializeNewNode(ctx, b.Graph, "Left Arm Skin", false)
-	ConnectNodes(ctx, muscleLeftArm, skinLeftArm, muscular, muscular)
-	ConnectNodes(ctx, muscleLeftArm, brain, neuronal, neuronal)
-
-	// Right Arm
-	muscleRightArm := InitializeNewNode(ctx, b.Graph, "Right Arm Muscle", false)
-	skinRightArm := InitializeNewNode(ctx, b.Graph, "Right Arm Skin", false)
-	ConnectNodes(ctx, muscleRightArm, skinRightArm, muscular, muscular)
-	ConnectNodes(ctx, muscleRightArm, brain, neuronal, neuronal)
-
-	// Left Leg
-	muscleLeftLeg := InitializeNewNode(ctx, b.Graph, "Left Leg Muscle", false)
-	skinLeftLeg := InitializeNewNode(ctx, b.Graph, "Left Leg Skin", false)
-	ConnectNodes(ctx, muscleLeftLeg, skinLeftLeg, muscular, muscular)
-	ConnectNodes(ctx, muscleLeftLeg, brain, neuronal, neuronal)
-
-	// Right Leg
-	muscleRightLeg := InitializeNewNode(ctx, b.Graph, "Right Leg Muscle", false)
-	skinRightLeg := InitializeNewNode(ctx, b.Graph, "Right Leg Skin", false)
-	ConnectNodes(ctx, muscleRightLeg, skinRightLeg, muscular, muscular)
-	ConnectNodes(ctx, muscleRightLeg, brain, neuronal, neuronal)
-
-	b.muscleNodes = append(b.muscleNodes,
-		muscleLeftArm,
-		muscleRightArm,
-		muscleLeftLeg,
-		muscleRightLeg,
-	)
-	b.skinNodes = append(b.skinNodes,
-		skinLeftArm,
-		skinRightArm,
-		skinLeftLeg,
-		skinRightLeg,
-	)
-
-	// Blood
-	bloodBrain := InitializeNewNode(ctx, b.Graph, "Blood - Brain", false)
-	ConnectNodes(ctx, bloodBrain, brain, blood_brain_barrier, blood_brain_barrier)
-	ConnectNodes(ctx, bloodBrain, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodBrain, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodBrain, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodBrain, kidneyRight, muscular, cardiovascular)
-	bloodHeart := InitializeNewNode(ctx, b.Graph, "Blood - Heart", false)
-	ConnectNodes(ctx, bloodHeart, heart, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodHeart, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodHeart, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodHeart, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodHeart, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodBrain, bloodHeart, cardiovascular, cardiovascular)
-	bloodLung := InitializeNewNode(ctx, b.Graph, "Blood - Lung", false)
-	ConnectNodes(ctx, bloodLung, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLung, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLung, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLung, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLung, bloodHeart, cardiovascular, cardiovascular)
-	bloodTorso := InitializeNewNode(ctx, b.Graph, "Blood - Torso", false)
-	ConnectNodes(ctx, bloodTorso, bloodLung, cardiovascular, cardiovascular)
-	ConnectNodes(ctx, bloodTorso, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodTorso, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodTorso, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodTorso, kidneyRight, muscular, cardiovascular)
-	bloodLeftArm := InitializeNewNode(ctx, b.Graph, "Blood - Left Arm", false)
-	ConnectNodes(ctx, bloodLeftArm, muscleLeftArm, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftArm, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftArm, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftArm, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftArm, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftArm, bloodTorso, cardiovascular, cardiovascular)
-	bloodRightArm := InitializeNewNode(ctx, b.Graph, "Blood - Right Arm", false)
-	ConnectNodes(ctx, bloodRightArm, muscleRightArm, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightArm, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightArm, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightArm, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightArm, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightArm, bloodTorso, cardiovascular, cardiovascular)
-	bloodLeftLeg := InitializeNewNode(ctx, b.Graph, "Blood - Left Leg", false)
-	ConnectNodes(ctx, bloodLeftLeg, muscleLeftLeg, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftLeg, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftLeg, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftLeg, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftLeg, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodLeftLeg, bloodTorso, cardiovascular, cardiovascular)
-	bloodRightLeg := InitializeNewNode(ctx, b.Graph, "Blood - Right Leg", false)
-	ConnectNodes(ctx, bloodRightLeg, muscleRightLeg, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightLeg, lungLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightLeg, lungRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightLeg, kidneyLeft, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightLeg, kidneyRight, muscular, cardiovascular)
-	ConnectNodes(ctx, bloodRightLeg, bloodTorso, cardiovascular, cardiovascular)
-	b.bloodNodes = append(b.bloodNodes,
-		bloodBrain,
-		bloodHeart,
-		bloodLung,
-		bloodTorso,
-		bloodLeftArm,
-		bloodRightArm,
-		bloodLeftLeg,
-		bloodRightLeg,
-	)
-
-	// Lymph Nodes
-	lymphHeart := InitializeNewNode(ctx, b.Graph, "Lymph Node - Heart", false)
-	ConnectNodes(ctx, lymphHeart, bloodHeart, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphHeart, heart, muscular, lymphatic)
-	lymphLung := InitializeNewNode(ctx, b.Graph, "Lymph Node - Lung", false)
-	ConnectNodes(ctx, lymphLung, bloodLung, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphLung, lymphHeart, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphLung, lungLeft, muscular, lymphatic)
-	ConnectNodes(ctx, lymphLung, lungRight, muscular, lymphatic)
-	lymphTorso := InitializeNewNode(ctx, b.Graph, "Lymph Node - Torso", false)
-	ConnectNodes(ctx, lymphTorso, bloodTorso, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphTorso, lymphLung, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphTorso, kidneyLeft, muscular, lymphatic)
-	ConnectNodes(ctx, lymphTorso, kidneyRight, muscular, lymphatic)
-	lymphLeftArm := InitializeNewNode(ctx, b.Graph, "Lymph Node - Left Arm", false)
-	ConnectNodes(ctx, lymphLeftArm, bloodLeftArm, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphLeftArm, lymphTorso, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphLeftArm, muscleLeftArm, muscular, lymphatic)
-	lymphRightArm := InitializeNewNode(ctx, b.Graph, "Lymph Node - Right Arm", false)
-	ConnectNodes(ctx, lymphRightArm, bloodRightArm, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphRightArm, lymphTorso, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphRightArm, muscleRightArm, muscular, lymphatic)
-	lymphLeftLeg := InitializeNewNode(ctx, b.Graph, "Lymph Node - Left Leg", false)
-	ConnectNodes(ctx, lymphLeftLeg, bloodLeftLeg, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphLeftLeg, lymphTorso, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphLeftLeg, muscleLeftLeg, cardiovascular, lymphatic)
-	lymphRightLeg := InitializeNewNode(ctx, b.Graph, "Lymph Node - Right Leg", false)
-	ConnectNodes(ctx, lymphRightLeg, bloodRightLeg, cardiovascular, lymphatic)
-	ConnectNodes(ctx, lymphRightLeg, lymphTorso, lymphatic, lymphatic)
-	ConnectNodes(ctx, lymphRightLeg, muscleRightLeg, cardiovascular, lymphatic)
-	b.lymphNodes = append(b.lymphNodes,
-		lymphHeart,
-		lymphLung,
-		lymphTorso,
-		lymphLeftArm,
-		lymphRightArm,
-		lymphLeftLeg,
-		lymphRightLeg,
-	)
-
-	// Bone Marrow
-	boneLeftArm := InitializeNewNode(ctx, b.Graph, "Bone - Left Arm", false)
-	ConnectNodes(ctx, boneLeftArm, bloodLeftArm, cardiovascular, skeletal)
-	boneRightArm := InitializeNewNode(ctx, b.Graph, "Bone - Right Arm", false)
-	ConnectNodes(ctx, boneRightArm, bloodRightArm, cardiovascular, skeletal)
-	boneLeftLeg := InitializeNewNode(ctx, b.Graph, "Bone - Left Leg", false)
-	ConnectNodes(ctx, boneLeftLeg, bloodLeftLeg, cardiovascular, skeletal)
-	boneRightLeg := InitializeNewNode(ctx, b.Graph, "Bone - Right Leg", false)
-	ConnectNodes(ctx, boneRightLeg, bloodRightLeg, cardiovascular, skeletal)
-	boneTorso := InitializeNewNode(ctx, b.Graph, "Bone - Torso", false)
-	ConnectNodes(ctx, boneTorso, bloodTorso, cardiovascular, skeletal)
-	b.boneNodes = append(b.boneNodes,
-		boneLeftArm,
-		boneRightArm,
-		boneLeftLeg,
-		boneRightLeg,
-		boneTorso,
-	)
-
-	// Gut
-	gut := InitializeNewNode(ctx, b.Graph, "Gut", false)
-	ConnectNodes(ctx, gut, bloodTorso, cardiovascular, gut_lining)
-	ConnectNodes(ctx, gut, lymphTorso, lymphatic, gut_lining)
-	ConnectNodes(ctx, gut, muscleLeftArm, muscular, gut_lining)
-	ConnectNodes(ctx, gut, muscleRightArm, muscular, gut_lining)
-	ConnectNodes(ctx, gut, muscleLeftLeg, muscular, gut_lining)
-	ConnectNodes(ctx, gut, muscleRightLeg, muscular, gut_lining)
-	b.gutNodes = append(b.gutNodes, gut)
+}
 
-	b.GenerateCellsAndStart(ctx)
-	return b
+func (b *Body) addNode(ctx context.Context, anatomy *Anatomy, spec NodeSpec) *Node {
+	node := InitializeNewNode(ctx, b.Graph, spec.Name, spec.Verbose)
+	node.organ = spec.Organ
+	node.control = b.Control
+	node.antigenPool.SetMucosal(InGut(node))
+	seed := spec.Materials
+	if seed == nil {
+		seed = anatomy.Materials
+	}
+	if seed != nil {
+		node.materialPool.Seed(seed)
+	}
+	organ := b.OrganNodes(spec.Organ)
+	*organ = append(*organ, node)
+	return node
 }
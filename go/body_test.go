@@ -3,10 +3,169 @@ package main
 import (
 	"context"
 	"testing"
+	"time"
 )
 
+// testBody is a paused body restored at a set time, for tests that place its
+// cells by hand. It stops with the test.
+type testBody struct {
+	*Body
+	dnas  *DNAIndex
+	human *DNA
+	now   time.Time
+}
+
+func restoreTestBody(t *testing.T, now time.Time, nodes []*NodeSnapshot, edges ...*EdgeSnapshot) *testBody {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	b := &testBody{dnas: &DNAIndex{}, human: MakeDNA(HUMAN_DNA, HUMAN_NAME), now: now}
+	b.dnas.Add(b.human)
+	body, err := RestoreBody(ctx, &Snapshot{
+		Time:   now.UnixNano(),
+		Paused: true,
+		Dna:    b.dnas.dna,
+		Nodes:  nodes,
+		Edges:  edges,
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Body = body
+	return b
+}
+
+// restore places a cell made from dna in node, presenting proteins. The cell
+// isn't started, so the test is the only one driving it. Cells without a spawn
+// time are born when the body was restored.
+func (b *testBody) restore(node *Node, dna *DNA, snapshot *CellSnapshot, proteins ...Protein) CellActor {
+	if snapshot.SpawnTime == 0 {
+		snapshot.SpawnTime = b.now.UnixNano()
+	}
+	for _, protein := range proteins {
+		snapshot.Proteins = append(snapshot.Proteins, uint32(protein))
+	}
+	return node.placeCell(snapshot, []*DNA{dna}, nil)
+}
+
 func TestBodyGeneration(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	GenerateBody(ctx)
 }
+
+func TestDefaultAnatomy(t *testing.T) {
+	anatomy := DefaultAnatomy()
+	if len(anatomy.Nodes) != 39 {
+		t.Errorf("Expected 39 nodes, got: %v", len(anatomy.Nodes))
+	}
+	if len(anatomy.Edges) != 118 {
+		t.Errorf("Expected 118 edges, got: %v", len(anatomy.Edges))
+	}
+	if anatomy.Edges[0].ToNode2 != neuronal {
+		t.Errorf("Expected first edge to be neuronal, got: %v", anatomy.Edges[0].ToNode2)
+	}
+	for _, repertoire := range anatomy.Repertoires {
+		if repertoire.CellType == CellType_VirginTLymphocyte.String() {
+			t.Errorf("Expected T cells to come from the thymus, got a repertoire in %v", repertoire.Organ)
+		}
+	}
+}
+
+func TestInvalidAnatomy(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{"unknown organ", `{"nodes": [{"name": "Appendix", "organ": "appendix"}]}`},
+		{"unknown node", `{"nodes": [{"name": "Heart", "organ": "heart"}], "edges": [{"node1": "Heart", "node2": "Brain", "toNode2": "neuronal", "toNode1": "neuronal"}]}`},
+		{"unknown edge type", `{"nodes": [{"name": "Heart", "organ": "heart"}], "edges": [{"node1": "Heart", "node2": "Heart", "toNode2": "wormhole", "toNode1": "neuronal"}]}`},
+		{"unknown cell type", `{"nodes": [{"name": "Heart", "organ": "heart", "cells": [{"cellType": "Cardiocyte", "count": 1}]}]}`},
+		{"repertoire without organ", `{"nodes": [{"name": "Heart", "organ": "heart"}], "repertoires": [{"cellType": "BLymphocyte", "organ": "bone", "groups": 1}]}`},
+		{"negative cell count", `{"nodes": [{"name": "Heart", "organ": "heart", "cells": [{"cellType": "Cardiomyocyte", "count": -1}]}]}`},
+		{"repertoire without groups", `{"nodes": [{"name": "Bone", "organ": "bone"}], "repertoires": [{"cellType": "BLymphocyte", "organ": "bone", "groups": 0}]}`},
+		{"negative repertoire redundancy", `{"nodes": [{"name": "Bone", "organ": "bone"}], "repertoires": [{"cellType": "BLymphocyte", "organ": "bone", "groups": 1, "redundancy": -1}]}`},
+	}
+	for _, c := range cases {
+		if _, err := ParseAnatomy([]byte(c.data)); err == nil {
+			t.Errorf("%v: expected an error", c.name)
+		}
+	}
+}
+
+func TestDefaultScenario(t *testing.T) {
+	scenario := DefaultScenario()
+	if len(scenario.Exposures) == 0 {
+		t.Fatalf("expected the default scenario to have exposures")
+	}
+	anatomy := DefaultAnatomy()
+	for _, exposure := range scenario.Exposures {
+		found := false
+		for _, node := range anatomy.Nodes {
+			found = found || node.Name == exposure.Node
+		}
+		if !found {
+			t.Errorf("exposure %v targets a node missing from the default anatomy", exposure)
+		}
+	}
+}
+
+func TestInvalidScenario(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{"missing pathogen", `{"exposures": [{"kind": "bacteria", "node": "Left Lung", "dose": 1}]}`},
+		{"unknown kind", `{"exposures": [{"pathogen": "Prion", "kind": "prion", "node": "Brain", "dose": 1}]}`},
+		{"virus without target", `{"exposures": [{"pathogen": "Flu", "kind": "virus", "node": "Left Lung", "dose": 1}]}`},
+		{"untargetable cell type", `{"exposures": [{"pathogen": "Coxsackie", "kind": "virus", "targetCellType": "Bacteroidota", "node": "Gut", "dose": 1}]}`},
+		{"bad offset", `{"exposures": [{"pathogen": "Flu", "kind": "bacteria", "node": "Left Lung", "dose": 1, "offset": "soon"}]}`},
+		{"negative dose", `{"exposures": [{"pathogen": "Flu", "kind": "bacteria", "node": "Left Lung", "dose": -1}]}`},
+		{"unknown action", `{"exposures": [{"action": "inhale", "pathogen": "Flu", "kind": "bacteria", "node": "Left Lung", "dose": 1}]}`},
+	}
+	for _, c := range cases {
+		if _, err := ParseScenario([]byte(c.data)); err == nil {
+			t.Errorf("%v: expected an error", c.name)
+		}
+	}
+}
+
+func TestExposureActions(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(1000, 0), []*NodeSnapshot{{Name: "Shot Arm", Organ: "muscle"}})
+	node := body.FindNode("Shot Arm")
+	vaccine := Exposure{Action: VACCINATE_ACTION, Pathogen: "Vaccine Bacteria", Kind: BACTERIA_PATHOGEN, Node: "Shot Arm", Dose: 1}
+	dna := body.pathogens.GetDNA(vaccine)
+	ctx, cancel := context.WithCancel(context.Background())
+	body.Expose(ctx, vaccine)
+	proteins := node.antigenPool.SampleProteins(context.Background(), time.Second, len(dna.selfProteins))
+	if len(proteins) != len(dna.selfProteins) || proteins[0] != dna.selfProteins[0] {
+		t.Errorf("Expected the vaccine to deposit the pathogen's proteins, got: %v", proteins)
+	}
+	if len(node.Cells()) != 0 {
+		t.Errorf("Expected the vaccine not to carry live pathogens, got: %v", node.Cells())
+	}
+
+	// A cancelled exposure stops depositing rather than blocking on the pool.
+	cancel()
+	body.Expose(ctx, Exposure{Action: VACCINATE_ACTION, Pathogen: "Vaccine Bacteria", Kind: BACTERIA_PATHOGEN, Node: "Shot Arm", Dose: PROTEIN_CHAN_BUFFER})
+	if node.antigenPool.DepositProteins(ctx, dna.selfProteins) {
+		t.Errorf("Expected a cancelled deposit to give up")
+	}
+
+	infusion := Exposure{Action: IMMUNIZE_ACTION, Pathogen: "Vaccine Bacteria", Kind: BACTERIA_PATHOGEN, Node: "Shot Arm", Dose: 50, Protein: 1}
+	body.Expose(context.Background(), infusion)
+	if antibodies := node.antigenPool.GetAntibodyLoad(); antibodies != 50 {
+		t.Errorf("Expected an infusion of antibodies, got: %v", antibodies)
+	}
+	if _, ok := node.antigenPool.antibodyLoads.Load(antibodyKey{dna.selfProteins[1], AntibodyIsotype_IgG}); !ok {
+		t.Errorf("Expected the antibodies to target the second protein")
+	}
+	infusion.Protein = len(dna.selfProteins)
+	if err := body.RunScenario(context.Background(), &Scenario{Exposures: []Exposure{infusion}}); err == nil {
+		t.Errorf("Expected an error immunizing against a protein the pathogen doesn't have")
+	}
+	infusion.Action, infusion.Protein = VACCINATE_ACTION, 1
+	if err := (&Scenario{Exposures: []Exposure{infusion}}).Validate(); err == nil {
+		t.Errorf("Expected an error choosing a protein for a vaccine")
+	}
+}
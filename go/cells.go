@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,7 +27,10 @@ type CellActor interface {
 	Tissue() *Tissue
 	Render() *Renderable
 	GetCellStatus() *CellStatus
+	Snapshot(*DNAIndex) *CellSnapshot
+	Restore(*CellSnapshot)
 	DoesWork() bool
+	WorkType() WorkType
 	DoWork(ctx context.Context)
 	Position() image.Point
 	LastPositions() *ring.Ring
@@ -34,6 +38,7 @@ type CellActor interface {
 	BroadcastExistence(ctx context.Context)
 	SpawnTime() time.Time
 	Function() *StateDiagram
+	MakeFunction(CellActor) *StateDiagram
 	WillMitosis(context.Context) bool
 	Mitosis(ctx context.Context) bool
 	CollectResources(context.Context) bool
@@ -43,7 +48,7 @@ type CellActor interface {
 	Repair(int)
 	ShouldIncurDamage(context.Context) bool
 	IncurDamage(int)
-	Apoptosis(bool)
+	Apoptosis(bool, ApoptosisCause)
 	IsApoptosis() bool
 	IsAerobic() bool
 	IsOxygenated() bool
@@ -65,10 +70,17 @@ type CellActor interface {
 	DropCytokine(t CytokineType, concentration uint8) uint8
 	AntibodyLoad() *AntibodyLoad
 	AddAntibodyLoad(*AntibodyLoad)
+	Opsonins() int
+	Opsonize(int)
 	ViralLoad() *ViralLoad
 	AddViralLoad(*ViralLoad)
 	MHC_II() *MHC_II
 	ReportCellAction(CellActionStatus)
+	LogEvent(Event)
+	Lineage() Lineage
+	SetLineage(Lineage)
+	Rand() *rand.Rand
+	Clock() *Clock
 }
 
 func BroadcastExistence(ctx context.Context, c CellActor) {
@@ -92,6 +104,7 @@ type Cell struct {
 	damage        int
 	oxygenated    bool
 	function      *StateDiagram
+	actor         CellActor // The cell type embedding this Cell, once it runs.
 	render        *Renderable
 	stop          context.CancelFunc
 	transportPath [10]string
@@ -99,14 +112,100 @@ type Cell struct {
 	spawnTime     time.Time
 	transportTime time.Time
 	antibodyLoad  *AntibodyLoad
+	opsonins      int // Complement bound to the cell's surface.
 	viralLoad     *ViralLoad
 	cellActions   *ring.Ring
+	rand          *rand.Rand
+	clock         *Clock
+	lineage       Lineage
 }
 
 func (c *Cell) String() string {
 	return fmt.Sprintf("%v (%v)", c.cellType, c.dna.name)
 }
 
+func (c *Cell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	// The cell keeps running while it is recorded.
+	c.RLock()
+	defer c.RUnlock()
+	transportPath, wantPath := c.transportPath, c.wantPath
+	snapshot := &CellSnapshot{
+		CellType:        c.cellType,
+		WorkType:        c.workType,
+		Dna:             dnas.Add(c.dna),
+		RenderId:        string(c.render.id),
+		Position:        &Position{X: int32(c.render.position.X), Y: int32(c.render.position.Y)},
+		Target:          &Position{X: int32(c.render.targetX), Y: int32(c.render.targetY), Z: int32(c.render.targetZ)},
+		FollowId:        string(c.render.followId),
+		Damage:          int32(c.damage),
+		Opsonins:        int32(c.opsonins),
+		Oxygenated:      c.oxygenated,
+		SpawnTime:       c.spawnTime.UnixNano(),
+		TransportTime:   c.transportTime.UnixNano(),
+		TransportPath:   transportPath[:],
+		WantPath:        wantPath[:],
+		LineageId:       c.lineage.ID,
+		ParentLineageId: c.lineage.Parent,
+		CellActions:     c.CellActions(),
+	}
+	if c.resourceNeed != nil {
+		snapshot.ResourceNeed = &ResourceBlobSocketData{
+			O2:       int32(c.resourceNeed.o2),
+			Glucose:  int32(c.resourceNeed.glucose),
+			Vitamins: int32(c.resourceNeed.vitamins),
+		}
+	}
+	if c.function != nil {
+		snapshot.State = int32(c.function.Cursor())
+	}
+	if c.viralLoad != nil {
+		snapshot.ViralLoad = c.viralLoad.Snapshot(dnas)
+	}
+	if c.antibodyLoad != nil {
+		snapshot.AntibodyLoad = c.antibodyLoad.Snapshot()
+	}
+	return snapshot
+}
+
+// Restore applies a snapshot to a newly made cell, before it starts. Viral
+// loads and the state diagram cursor are restored once it has started.
+func (c *Cell) Restore(snapshot *CellSnapshot) {
+	if snapshot.RenderId != "" {
+		c.render.id = RenderID(snapshot.RenderId)
+	}
+	if snapshot.Position != nil {
+		c.render.position = image.Pt(int(snapshot.Position.X), int(snapshot.Position.Y))
+	}
+	if snapshot.Target != nil {
+		c.render.targetX = int(snapshot.Target.X)
+		c.render.targetY = int(snapshot.Target.Y)
+		c.render.targetZ = int(snapshot.Target.Z)
+	}
+	c.render.followId = RenderID(snapshot.FollowId)
+	c.damage = int(snapshot.Damage)
+	c.opsonins = int(snapshot.Opsonins)
+	c.oxygenated = snapshot.Oxygenated
+	c.spawnTime = time.Unix(0, snapshot.SpawnTime)
+	c.transportTime = time.Unix(0, snapshot.TransportTime)
+	c.lineage = Lineage{
+		ID:     snapshot.LineageId,
+		Parent: snapshot.ParentLineageId,
+	}
+	if snapshot.AntibodyLoad != nil {
+		c.antibodyLoad = RestoreAntibodyLoad(snapshot.AntibodyLoad)
+	}
+	if snapshot.ResourceNeed != nil {
+		c.resourceNeed = &ResourceBlob{
+			o2:       int(snapshot.ResourceNeed.O2),
+			glucose:  int(snapshot.ResourceNeed.Glucose),
+			vitamins: int(snapshot.ResourceNeed.Vitamins),
+		}
+	}
+	for _, action := range snapshot.CellActions {
+		c.ReportCellAction(action)
+	}
+}
+
 func (c *Cell) SetStop(stop context.CancelFunc) {
 	c.stop = stop
 }
@@ -125,6 +224,23 @@ func (c *Cell) Verbose() bool {
 
 func (c *Cell) SetOrgan(node *Node) {
 	c.organ = node
+	if node != nil && c.clock == nil {
+		c.clock = node.clock
+	}
+}
+
+func (c *Cell) Clock() *Clock {
+	if c.clock == nil {
+		return defaultClock
+	}
+	return c.clock
+}
+
+func (c *Cell) Rand() *rand.Rand {
+	if c.rand == nil {
+		return unownedRand
+	}
+	return c.rand
 }
 
 func (c *Cell) Tissue() *Tissue {
@@ -147,6 +263,25 @@ func (c *Cell) ReportCellAction(action CellActionStatus) {
 	}
 }
 
+func (c *Cell) Lineage() Lineage {
+	return c.lineage
+}
+
+func (c *Cell) SetLineage(lineage Lineage) {
+	c.lineage = lineage
+}
+
+func (c *Cell) LogEvent(event Event) {
+	if c.organ == nil {
+		return
+	}
+	event.CellId = string(c.render.id)
+	event.CellType = c.cellType.String()
+	event.Lineage = c.lineage.ID
+	event.Parent = c.lineage.Parent
+	c.organ.LogEvent(event)
+}
+
 func (c *Cell) GetCellStatus() *CellStatus {
 	viralLoad := int64(0)
 	if c.viralLoad != nil {
@@ -163,17 +298,8 @@ func (c *Cell) GetCellStatus() *CellStatus {
 			presented = append(presented, uint32(p))
 		}
 	}
-	var cellActions []CellActionStatus
-	if c.cellActions != nil {
-		c.cellActions.Do(func(p any) {
-			if p != nil {
-				cellActions = append(cellActions, p.(CellActionStatus))
-			}
-		})
-	}
-
 	return &CellStatus{
-		Timestamp:     time.Now().Unix(),
+		Timestamp:     c.Clock().Now().Unix(),
 		CellType:      c.cellType,
 		Name:          c.dna.name,
 		RenderId:      string(c.render.id),
@@ -184,8 +310,20 @@ func (c *Cell) GetCellStatus() *CellStatus {
 		WantPath:      c.wantPath[:],
 		Proteins:      proteins,
 		Presented:     presented,
-		CellActions:   cellActions,
+		CellActions:   c.CellActions(),
+	}
+}
+
+// CellActions lists the last actions the cell took, oldest first.
+func (c *Cell) CellActions() (cellActions []CellActionStatus) {
+	if c.cellActions != nil {
+		c.cellActions.Do(func(p any) {
+			if p != nil {
+				cellActions = append(cellActions, p.(CellActionStatus))
+			}
+		})
 	}
+	return
 }
 
 func (c *Cell) DNA() *DNA {
@@ -200,10 +338,24 @@ func (c *Cell) CellType() CellType {
 	return c.cellType
 }
 
+func (c *Cell) Spec() *CellTypeSpec {
+	return LookupCellType(c.cellType)
+}
+
 func (c *Cell) Function() *StateDiagram {
 	return c.function
 }
 
+// MakeFunction compiles the state diagram of the cell from its DNA, unless a
+// restore already did so before the cell started.
+func (c *Cell) MakeFunction(actor CellActor) *StateDiagram {
+	c.actor = actor
+	if c.function == nil {
+		c.function = c.dna.makeFunction(actor, c.dna)
+	}
+	return c.function
+}
+
 func (c *Cell) WorkType() WorkType {
 	return c.workType
 }
@@ -213,10 +365,14 @@ func (c *Cell) IsOxygenated() bool {
 }
 
 func (c *Cell) Oxygenate(oxygenate bool) {
+	c.Lock()
 	c.oxygenated = oxygenate
+	c.Unlock()
 }
 
 func (c *Cell) Damage() int {
+	c.RLock()
+	defer c.RUnlock()
 	return c.damage
 }
 
@@ -225,11 +381,13 @@ func (c *Cell) CanRepair() bool {
 }
 
 func (c *Cell) Repair(damage int) {
+	c.Lock()
 	if c.damage <= damage {
 		c.damage = 0
 	} else {
 		c.damage -= damage
 	}
+	c.Unlock()
 	if damage > 0 {
 		c.ReportCellAction(CellActionStatus_repair)
 	}
@@ -246,12 +404,15 @@ func (c *Cell) ShouldIncurDamage(ctx context.Context) bool {
 	c.Organ().materialPool.PutWaste(waste)
 	return hasAntibodies ||
 		waste.creatinine >= DAMAGE_CREATININE_THRESHOLD ||
+		waste.ammonia >= DAMAGE_AMMONIA_THRESHOLD ||
 		waste.co2 >= DAMAGE_CO2_THRESHOLD ||
 		c.GetCytokineConcentrationAt(CytokineType_cytotoxins, c.Position()) > CYTOTOXIN_DAMAGE_THRESHOLD
 }
 
 func (c *Cell) IncurDamage(damage int) {
+	c.Lock()
 	c.damage += int(damage)
+	c.Unlock()
 	if damage > 0 {
 		c.ReportCellAction(CellActionStatus_incur_damage)
 	}
@@ -259,6 +420,9 @@ func (c *Cell) IncurDamage(damage int) {
 
 func (c *Cell) CleanUp() {
 	c.render.visible = false
+	if c.organ != nil {
+		c.organ.RemoveCell(c.render.id)
+	}
 	if c.organ != nil && c.organ.tissue != nil {
 		c.organ.tissue.Detach(c.render)
 	}
@@ -270,17 +434,21 @@ func (c *Cell) CleanUp() {
 	c.organ = nil
 }
 
-func (c *Cell) Apoptosis(release bool) {
+func (c *Cell) Apoptosis(release bool, cause ApoptosisCause) {
 	if c.Verbose() {
 		fmt.Println("Apoptosis:", c, "in", c.organ)
 	}
+	c.LogEvent(Event{Type: APOPTOSIS_EVENT, Cause: cause})
+	if c.organ != nil {
+		c.organ.lineage.Die(c.lineage.ID, c.Clock().Now(), cause)
+	}
 	if release {
 		// Deposit viral load and proteins into protein pool.
 		if c.organ != nil && c.organ.antigenPool != nil {
 			if c.viralLoad != nil {
 				c.organ.antigenPool.DepositViralLoad(c.viralLoad)
 			}
-			go c.organ.antigenPool.DepositProteins(c.dna.selfProteins)
+			go c.organ.antigenPool.DepositProteins(context.Background(), c.dna.selfProteins)
 		}
 	}
 	c.ReportCellAction(CellActionStatus_apoptosis)
@@ -291,6 +459,14 @@ func (c *Cell) IsApoptosis() bool {
 	return c.Tissue() == nil
 }
 
+func (c *Cell) DoesWork() bool {
+	return c.Spec().DoesWork
+}
+
+func (c *Cell) IsAerobic() bool {
+	return c.Spec().Aerobic
+}
+
 func (c *Cell) DoWork(ctx context.Context) {
 	panic("unimplemented")
 }
@@ -311,56 +487,8 @@ func (c *Cell) Work(ctx context.Context, request Work) Work {
 		return request
 	}
 	c.Lock()
-	switch c.cellType {
-	case CellType_RedBlood:
-		c.organ.materialPool.PutWaste(&WasteBlob{
-			co2: CELLULAR_TRANSPORT_CO2,
-		})
-	case CellType_Enterocyte:
-		c.organ.materialPool.PutResource(&ResourceBlob{
-			glucose:  GLUCOSE_INTAKE,
-			vitamins: VITAMIN_INTAKE,
-		})
-	case CellType_Podocyte:
-		waste := c.organ.materialPool.GetWaste(ctx)
-		if waste.creatinine > CREATININE_GROWTH_THRESHOLD {
-			c.organ.materialPool.PutLigand(&LigandBlob{
-				growth: CREATININE_LIGAND_GROWTH,
-			})
-		}
-		if waste.creatinine <= CREATININE_FILTRATE {
-			waste.creatinine = 0
-		} else {
-			waste.creatinine -= CREATININE_FILTRATE
-		}
-		c.organ.materialPool.PutWaste(waste)
-	case CellType_Pneumocyte:
-		waste := c.organ.materialPool.GetWaste(ctx)
-		if waste.co2 <= CELLULAR_TRANSPORT_CO2 {
-			waste.co2 = 0
-		} else {
-			waste.co2 -= CELLULAR_TRANSPORT_CO2
-		}
-		c.organ.materialPool.PutWaste(waste)
-		c.organ.materialPool.PutResource(&ResourceBlob{
-			o2: LUNG_O2_INTAKE,
-		})
-	case CellType_Cardiomyocyte:
-		request := c.organ.RequestWork(ctx, Work{
-			workType: WorkType_exhale,
-		})
-		if request.status == 200 {
-			c.organ.materialPool.PutResource(&ResourceBlob{
-				o2: CELLULAR_TRANSPORT_O2,
-			})
-		}
-	case CellType_Myocyte:
-		fallthrough
-	case CellType_Keratinocyte:
-		fallthrough
-	case CellType_Neuron:
-		fallthrough
-	default:
+	if work := c.Spec().Work; work != nil {
+		work(ctx, c)
 	}
 	request.status = 200
 	request.result = "Completed."
@@ -371,7 +499,13 @@ func (c *Cell) Work(ctx context.Context, request Work) Work {
 }
 
 func (c *Cell) PresentProteins() (proteins []Protein) {
-	if c.function != nil && c.function.current != nil {
+	if c.function == nil {
+		return
+	}
+	// Other cells ask while the cell's own diagram moves on.
+	c.function.RLock()
+	defer c.function.RUnlock()
+	if c.function.current != nil {
 		return c.function.current.function.proteins
 	}
 	return
@@ -387,6 +521,10 @@ func (c *Cell) CollectResources(ctx context.Context) bool {
 	if c.resourceNeed == nil {
 		c.ResetResourceNeed()
 	}
+	if c.organ != nil && c.resourceNeed.glucose > 0 && c.Spec().InsulinDependent && !c.organ.materialPool.BindInsulin(ctx) {
+		// Without insulin, the glucose stays where it is.
+		return false
+	}
 	for !reflect.DeepEqual(c.resourceNeed, new(ResourceBlob)) {
 		select {
 		case <-ctx.Done():
@@ -396,7 +534,9 @@ func (c *Cell) CollectResources(ctx context.Context) bool {
 				cancel()
 			} else {
 				resource := c.organ.materialPool.GetResource(ctx)
+				c.Lock()
 				resource.Consume(c.resourceNeed)
+				c.Unlock()
 				c.organ.materialPool.PutResource(resource)
 			}
 		}
@@ -406,127 +546,16 @@ func (c *Cell) CollectResources(ctx context.Context) bool {
 }
 
 func (c *Cell) ResetResourceNeed() {
-	switch c.cellType {
-	case CellType_Keratinocyte:
-		fallthrough
-	case CellType_Enterocyte:
-		fallthrough
-	case CellType_Podocyte:
-		fallthrough
-	case CellType_Pneumocyte:
-		fallthrough
-	case CellType_Hemocytoblast:
-		fallthrough
-	case CellType_Lymphoblast:
-		fallthrough
-	case CellType_Myeloblast:
-		fallthrough
-	case CellType_Monocyte:
-		fallthrough
-	case CellType_Macrophagocyte:
-		fallthrough
-	case CellType_Dendritic:
-		fallthrough
-	case CellType_Neutrocyte:
-		fallthrough
-	case CellType_NaturalKillerCell:
-		fallthrough
-	case CellType_VirginTLymphocyte:
-		fallthrough
-	case CellType_HelperTLymphocyte:
-		fallthrough
-	case CellType_KillerTLymphocyte:
-		fallthrough
-	case CellType_BLymphocyte:
-		fallthrough
-	case CellType_EffectorBLymphocyte:
-		c.resourceNeed = &ResourceBlob{
-			o2:      0,
-			glucose: 0,
-		}
-	case CellType_RedBlood:
-		c.resourceNeed = &ResourceBlob{
-			o2:      CELLULAR_TRANSPORT_O2,
-			glucose: CELLULAR_TRANSPORT_GLUCOSE,
-		}
-	case CellType_Bacteria:
-		fallthrough
-	case CellType_Bacteroidota:
-		fallthrough
-	case CellType_Neuron:
-		fallthrough
-	case CellType_Cardiomyocyte:
-		fallthrough
-	case CellType_Myocyte:
-		fallthrough
-	default:
-		c.resourceNeed = &ResourceBlob{
-			o2:      CELLULAR_RESPIRATION_O2,
-			glucose: CELLULAR_RESPIRATION_GLUCOSE,
-		}
-	}
+	need := c.Spec().ResourceNeed
+	c.Lock()
+	c.resourceNeed = &need
+	c.Unlock()
 }
 
 func (c *Cell) ProduceWaste() {
 	if c.organ.materialPool != nil {
-		switch c.cellType {
-		case CellType_Pneumocyte:
-			fallthrough
-		case CellType_Keratinocyte:
-			fallthrough
-		case CellType_Enterocyte:
-			fallthrough
-		case CellType_Podocyte:
-			fallthrough
-		case CellType_RedBlood:
-			fallthrough
-		case CellType_Hemocytoblast:
-			fallthrough
-		case CellType_Lymphoblast:
-			fallthrough
-		case CellType_Myeloblast:
-			fallthrough
-		case CellType_Monocyte:
-			fallthrough
-		case CellType_Macrophagocyte:
-			fallthrough
-		case CellType_Dendritic:
-			fallthrough
-		case CellType_Neutrocyte:
-			fallthrough
-		case CellType_NaturalKillerCell:
-			fallthrough
-		case CellType_VirginTLymphocyte:
-			fallthrough
-		case CellType_HelperTLymphocyte:
-			fallthrough
-		case CellType_KillerTLymphocyte:
-			fallthrough
-		case CellType_BLymphocyte:
-			fallthrough
-		case CellType_EffectorBLymphocyte:
-			// No waste produced.
-		case CellType_Bacteroidota:
-			c.organ.materialPool.PutWaste(&WasteBlob{
-				co2: CELLULAR_RESPIRATION_CO2,
-			})
-			c.Organ().materialPool.PutResource(&ResourceBlob{
-				vitamins: BACTERIA_VITAMIN_PRODUCTION,
-			})
-		case CellType_Bacteria:
-			c.DropCytokine(CytokineType_cytotoxins, CYTOKINE_CYTOTOXINS)
-			fallthrough
-		case CellType_Cardiomyocyte:
-			fallthrough
-		case CellType_Myocyte:
-			fallthrough
-		case CellType_Neuron:
-			fallthrough
-		default:
-			c.organ.materialPool.PutWaste(&WasteBlob{
-				creatinine: CREATININE_PRODUCTION,
-				co2:        CELLULAR_RESPIRATION_CO2,
-			})
+		if produceWaste := c.Spec().ProduceWaste; produceWaste != nil {
+			produceWaste(c)
 		}
 	}
 }
@@ -642,7 +671,7 @@ func (c *Cell) MoveTowardsCytokines(t []CytokineType) bool {
 		}
 	}
 	if len(indices) > 0 {
-		moveToPoint := points[indices[rand.Intn(len(indices))]]
+		moveToPoint := points[indices[c.Rand().Intn(len(indices))]]
 		c.MoveToPoint(moveToPoint)
 	}
 	return len(indices) > 0
@@ -663,7 +692,7 @@ func (c *Cell) MoveAwayFromCytokines(t []CytokineType) bool {
 		}
 	}
 	if len(indices) > 0 {
-		moveToPoint := points[indices[rand.Intn(len(indices))]]
+		moveToPoint := points[indices[c.Rand().Intn(len(indices))]]
 		c.MoveToPoint(moveToPoint)
 	}
 	return len(indices) > 0
@@ -678,7 +707,7 @@ func (c *Cell) DropCytokine(t CytokineType, concentration uint8) uint8 {
 }
 
 func (c *Cell) CanInteract() bool {
-	return false
+	return c.Spec().CanInteract
 }
 
 func (c *Cell) Interact(context.Context, CellActor) {
@@ -695,7 +724,7 @@ func (c *Cell) GetInteractions(ctx context.Context) (interactions []CellActor) {
 }
 
 func (c *Cell) CanTransport() bool {
-	return false
+	return c.Spec().CanTransport
 }
 
 func (c *Cell) ShouldTransport(context.Context) bool {
@@ -707,7 +736,7 @@ func (c *Cell) WantPath() [10]string {
 }
 
 func (c *Cell) WantEdgeType() []EdgeType {
-	return nil
+	return c.Spec().WantEdgeTypes
 }
 
 func (c *Cell) TransportPath() [10]string {
@@ -721,9 +750,10 @@ func Transport(c CellActor) bool {
 	}
 	var transportEdges []*Edge
 	var wantEdges []EdgeType
+	edges := o.Edges()
 	for _, edgeType := range c.WantEdgeType() {
 		found := false
-		for _, edge := range o.edges {
+		for _, edge := range edges {
 			if edge.edgeType == edgeType {
 				found = true
 			}
@@ -732,7 +762,10 @@ func Transport(c CellActor) bool {
 			wantEdges = append(wantEdges, edgeType)
 		}
 	}
-	for _, e := range o.edges {
+	for _, e := range edges {
+		if !e.Healthy() {
+			continue
+		}
 		switch e.edgeType {
 		case blood_brain_barrier:
 			fallthrough
@@ -740,6 +773,13 @@ func Transport(c CellActor) bool {
 			fallthrough
 		case gut_lining:
 			// Pass
+		case thymic:
+			// Only cells that want to cross into the thymus.
+			for _, wantEdge := range wantEdges {
+				if wantEdge == thymic {
+					transportEdges = append(transportEdges, e)
+				}
+			}
 		default:
 			if len(wantEdges) > 0 {
 				for _, wantEdge := range wantEdges {
@@ -773,7 +813,7 @@ func Transport(c CellActor) bool {
 		edge = transportEdges[foundIndex]
 	} else {
 		// Pick a random, valid edge to transport to.
-		edge = transportEdges[rand.Intn(len(transportEdges))]
+		edge = transportEdges[c.Rand().Intn(len(transportEdges))]
 		hasBeen := false
 		for i := 0; i < len(transportPath) && !hasBeen; i++ {
 			if edge.transportUrl == transportPath[i] {
@@ -782,23 +822,26 @@ func Transport(c CellActor) bool {
 		}
 		if hasBeen {
 			// If we've been to this edge before, reroll.
-			edge = transportEdges[rand.Intn(len(transportEdges))]
+			edge = transportEdges[c.Rand().Intn(len(transportEdges))]
 		}
 	}
-	err := MakeTransportRequest(edge.transportUrl, c.DNA().name, c.DNA(), c.CellType(), c.WorkType(), string(c.Render().id), c.SpawnTime(), c.TransportPath(), c.WantPath(), c.MHC_II().proteins)
+	err := o.TransportCell(edge.transportUrl, c)
 	if err != nil {
 		fmt.Printf("Unable to transport to %v: %v\n", edge.transportUrl, err)
 		return false
 	}
-	if c.CellType() == CellType_Bacteria {
-		fmt.Println("Bacteria transported to", edge.transportUrl)
-	}
+	atomic.AddInt64(&edge.transportCount, 1)
+	c.LogEvent(Event{Type: TRANSPORT_EVENT, Target: edge.transportUrl})
 	c.ReportCellAction(CellActionStatus_transport)
 	return true
 }
 
 func (c *Cell) RecordTransport() {
 	currentUrl := c.organ.transportUrl
+	now := c.Clock().Now()
+	c.Lock()
+	defer c.Unlock()
+	c.transportTime = now
 
 	var transportPath [10]string
 	copy(transportPath[0:], c.transportPath[1:])
@@ -820,23 +863,44 @@ func (c *Cell) RecordTransport() {
 }
 
 func (c *Cell) AntibodyLoad() *AntibodyLoad {
+	c.RLock()
+	defer c.RUnlock()
 	return c.antibodyLoad
 }
 
 func (c *Cell) AddAntibodyLoad(a *AntibodyLoad) {
+	c.Lock()
+	defer c.Unlock()
 	if c.antibodyLoad == nil {
 		c.antibodyLoad = &AntibodyLoad{
 			targetProtein: a.targetProtein,
+			isotype:       a.isotype,
 		}
 	}
 	c.antibodyLoad.Merge(a)
 }
 
+func (c *Cell) Opsonins() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.opsonins
+}
+
+func (c *Cell) Opsonize(complement int) {
+	c.Lock()
+	c.opsonins += complement
+	c.Unlock()
+}
+
 func (c *Cell) ViralLoad() *ViralLoad {
+	c.RLock()
+	defer c.RUnlock()
 	return c.viralLoad
 }
 
 func (c *Cell) AddViralLoad(v *ViralLoad) {
+	c.Lock()
+	defer c.Unlock()
 	if c.viralLoad == nil {
 		c.viralLoad = &ViralLoad{
 			virus: v.virus,
@@ -857,20 +921,11 @@ type EukaryoticCell struct {
 }
 
 func (e *EukaryoticCell) Start(ctx context.Context) {
-	e.function = e.dna.makeFunction(e, e.dna)
+	e.MakeFunction(e)
 	go e.function.Run(ctx, e)
 	e.Tissue().Attach(e.render)
 }
 
-func (e *EukaryoticCell) DoesWork() bool {
-	switch e.cellType {
-	case CellType_Hemocytoblast:
-		return false
-	default:
-		return true
-	}
-}
-
 func (e *EukaryoticCell) DoWork(ctx context.Context) {
 	e.organ.MakeAvailable(ctx, e)
 }
@@ -888,6 +943,8 @@ func (e *EukaryoticCell) PresentProteins() (proteins []Protein) {
 	if e.function == nil {
 		return
 	}
+	e.function.RLock()
+	defer e.function.RUnlock()
 	return e.function.current.function.proteins
 }
 
@@ -895,8 +952,9 @@ func (e *EukaryoticCell) Mitosis(ctx context.Context) bool {
 	if e.organ == nil {
 		return false
 	}
-	MakeTransportRequest(e.organ.transportUrl, e.dna.name, e.dna, e.cellType, e.workType, string(e.render.id), time.Now(), e.transportPath, e.wantPath, map[Protein]bool{})
+	e.organ.MakeTransportRequest(e.organ.transportUrl, e.dna.name, e.dna, e.cellType, e.workType, string(e.render.id), e.Clock().Now(), e.transportPath, e.wantPath, map[Protein]bool{}, Lineage{Parent: e.lineage.ID}, e.Rand().Int63())
 	e.ReportCellAction(CellActionStatus_mitosis)
+	e.LogEvent(Event{Type: MITOSIS_EVENT})
 	return true
 }
 
@@ -918,84 +976,12 @@ func (e *EukaryoticCell) CleanUp() {
 }
 
 func (e *EukaryoticCell) WillMitosis(ctx context.Context) bool {
-	switch e.cellType {
-	case CellType_Hemocytoblast:
-		// Bootstrap the mitosis function to spawn leukocyte stem cells, but only in the present of the hormone.
-		hormone := e.organ.materialPool.GetHormone(ctx)
-		if hormone.granulocyte_csf >= HORMONE_CSF_THRESHOLD {
-			hormone.granulocyte_csf -= HORMONE_CSF_THRESHOLD
-			MakeTransportRequest(e.organ.transportUrl, e.dna.name, e.dna, CellType_Myeloblast, WorkType_nothing, string(e.render.id), time.Now(), e.transportPath, e.wantPath, nil)
-		}
-		if hormone.macrophage_csf >= HORMONE_M_CSF_THRESHOLD {
-			hormone.macrophage_csf -= HORMONE_M_CSF_THRESHOLD
-			MakeTransportRequest(e.organ.transportUrl, e.dna.name, e.dna, CellType_Monocyte, WorkType_nothing, string(e.render.id), time.Now(), e.transportPath, e.wantPath, nil)
-		}
-		if hormone.interleukin_3 >= HORMONE_IL3_THRESHOLD {
-			hormone.interleukin_3 -= HORMONE_IL3_THRESHOLD
-			MakeTransportRequest(e.organ.transportUrl, e.dna.name, e.dna, CellType_Lymphoblast, WorkType_nothing, string(e.render.id), time.Now(), e.transportPath, e.wantPath, nil)
-		}
-		e.organ.materialPool.PutHormone(hormone)
-		fallthrough
-	default:
-		ligand := e.Organ().materialPool.GetLigand(ctx)
-		defer e.Organ().materialPool.PutLigand(ligand)
-		if ligand.growth >= LIGAND_GROWTH_THRESHOLD {
-			// Only checked if prior conditions are met.
-			ligand.growth -= LIGAND_GROWTH_THRESHOLD
-			return true
-		}
+	if willMitosis := e.Spec().WillMitosis; willMitosis != nil {
+		return willMitosis(ctx, e)
 	}
 	return false
 }
 
-func (e *EukaryoticCell) IsAerobic() bool {
-	switch e.cellType {
-	case CellType_Pneumocyte:
-		fallthrough
-	case CellType_Podocyte:
-		fallthrough
-	case CellType_Keratinocyte:
-		return false
-	}
-	return true
-}
-
-func CopyEukaryoticCell(base *EukaryoticCell) *EukaryoticCell {
-	position := image.Point{
-		base.render.position.X + RandInRange(-SPAWN_DISPLACEMENT, SPAWN_DISPLACEMENT),
-		base.render.position.Y + RandInRange(-SPAWN_DISPLACEMENT, SPAWN_DISPLACEMENT),
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &EukaryoticCell{
-		Cell: &Cell{
-			cellType: base.cellType,
-			dna:      base.dna,
-			mhc_i:    base.dna.MHC_I(),
-			workType: base.workType,
-			render: &Renderable{
-				id:            MakeRenderId(base.cellType.String()),
-				visible:       true,
-				position:      position,
-				targetX:       base.render.targetX,
-				targetY:       base.render.targetY,
-				targetZ:       base.render.targetZ,
-				lastPositions: positionTracker,
-				renderType: RenderType{
-					Type: &RenderType_CellType{
-						CellType: base.cellType,
-					},
-				},
-			},
-			transportPath: base.transportPath,
-			wantPath:      base.wantPath,
-			spawnTime:     base.spawnTime,
-			transportTime: base.transportTime,
-			cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-		},
-	}
-}
-
 type MHC_II struct {
 	sync.RWMutex
 	proteins  map[Protein]bool
@@ -1051,9 +1037,7 @@ func (m *MHC_II) ClearPresented() {
 
 type Leukocyte struct {
 	*Cell
-	lifeSpan      time.Duration
-	transportSpan time.Duration
-	mhc_ii        *MHC_II
+	mhc_ii *MHC_II
 }
 
 type AntigenPresenting interface {
@@ -1084,6 +1068,26 @@ func (i *Leukocyte) GetCellStatus() *CellStatus {
 	return status
 }
 
+func (i *Leukocyte) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := i.Cell.Snapshot(dnas)
+	for _, p := range i.mhc_ii.GetProteins() {
+		snapshot.Proteins = append(snapshot.Proteins, uint32(p))
+	}
+	for _, p := range i.mhc_ii.GetPresented() {
+		snapshot.Presented = append(snapshot.Presented, uint32(p))
+	}
+	return snapshot
+}
+
+func (i *Leukocyte) Restore(snapshot *CellSnapshot) {
+	i.Cell.Restore(snapshot)
+	var presented []Protein
+	for _, p := range snapshot.Presented {
+		presented = append(presented, Protein(p))
+	}
+	i.mhc_ii.SetPresented(presented)
+}
+
 func (i *Leukocyte) ShouldIncurDamage(ctx context.Context) bool {
 	return i.Cell.ShouldIncurDamage(ctx) || i.TimeLeft() < 0
 }
@@ -1093,49 +1097,21 @@ func (i *Leukocyte) IncurDamage(damage int) {
 	// Don't cause inflammation.
 }
 
-func (i *Leukocyte) Apoptosis(release bool) {
+func (i *Leukocyte) Apoptosis(release bool, cause ApoptosisCause) {
 	// Gracefully apoptosis if told to do so or lifetime is over.
 	shouldRelease := release && i.TimeLeft() > 0
-	i.Cell.Apoptosis(shouldRelease)
+	if i.TimeLeft() <= 0 && cause == DAMAGE_CAUSE {
+		cause = LIFESPAN_CAUSE
+	}
+	i.Cell.Apoptosis(shouldRelease, cause)
 }
 
 func (i *Leukocyte) TimeLeft() time.Duration {
-	return time.Until(i.spawnTime.Add(i.lifeSpan))
+	return i.Clock().Until(i.spawnTime.Add(i.Spec().LifeSpan))
 }
 
 func (i *Leukocyte) TimeToTransport() time.Duration {
-	return time.Until(i.transportTime.Add(i.transportSpan))
-}
-
-func (i *Leukocyte) CanTransport() bool {
-	switch i.cellType {
-	case CellType_Lymphoblast:
-		return true
-	case CellType_Myeloblast:
-		return true
-	case CellType_Monocyte:
-		return true
-	case CellType_Macrophagocyte:
-		return false
-	case CellType_Neutrocyte:
-		return true
-	case CellType_NaturalKillerCell:
-		return true
-	case CellType_VirginTLymphocyte:
-		return true
-	case CellType_HelperTLymphocyte:
-		return true
-	case CellType_KillerTLymphocyte:
-		return true
-	case CellType_BLymphocyte:
-		return false
-	case CellType_EffectorBLymphocyte:
-		return false
-	case CellType_Dendritic:
-		return true
-	default:
-		return false
-	}
+	return i.Clock().Until(i.transportTime.Add(i.Spec().TransportSpan))
 }
 
 func (i Leukocyte) ShouldTransport(ctx context.Context) bool {
@@ -1145,17 +1121,10 @@ func (i Leukocyte) ShouldTransport(ctx context.Context) bool {
 	if i.TimeToTransport() > 0 || i.organ == nil {
 		return false
 	}
-	switch i.cellType {
-	case CellType_Dendritic:
-		// If the dendritic cell was activated, it should not transport,
-		// and opt to die instead: https://www.ncbi.nlm.nih.gov/pmc/articles/PMC3282617/
-		return len(i.mhc_ii.presented) == 0
-	default:
-		// If there is no inflammation, move on.
-		ligand := i.organ.materialPool.GetLigand(ctx)
-		defer i.organ.materialPool.PutLigand(ligand)
-		return ligand.inflammation < LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD
-	}
+	// If there is no inflammation, move on.
+	ligand := i.organ.materialPool.GetLigand(ctx)
+	defer i.organ.materialPool.PutLigand(ligand)
+	return ligand.inflammation < LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD
 }
 
 func (i *Leukocyte) VerifySelf(antigen *Antigen) bool {
@@ -1190,120 +1159,44 @@ func (i *Leukocyte) FoundAntigenCytokine() bool {
 	return foundCytokine
 }
 
-func (i *Leukocyte) Trap(c CellActor) {
-	c.MoveToPoint(i.Position())
-}
-
-func (i *Leukocyte) DoesWork() bool {
-	return false
+// Anti-inflammatory cytokines keep the cells around them from activating.
+func (i *Leukocyte) IsSuppressed() bool {
+	return i.GetCytokineConcentrationAt(CytokineType_anti_inflammatory, i.Position()) >= CYTOKINE_SUPPRESSION_THRESHOLD
 }
 
-func (i *Leukocyte) IsAerobic() bool {
-	return false
+func (i *Leukocyte) Trap(c CellActor) {
+	c.MoveToPoint(i.Position())
 }
 
 func (i *Leukocyte) CanMove() bool {
 	return true
 }
 
-func (i *Leukocyte) CanInteract() bool {
-	switch i.cellType {
-	case CellType_VirginTLymphocyte:
-		fallthrough
-	case CellType_BLymphocyte:
-		fallthrough
-	case CellType_EffectorBLymphocyte:
-		return false
-	default:
-		return true
-	}
-}
-
 func (i *Leukocyte) WillMitosis(ctx context.Context) bool {
 	// Overloading mitosis with differentiation. Once differentiated, the existing cell will disappear.
-	switch i.cellType {
-	case CellType_Lymphoblast:
-		fallthrough
-	case CellType_Myeloblast:
-		fallthrough
-	case CellType_Monocyte:
-		// If there is no inflammation, don't differentiate.
-		ligand := i.organ.materialPool.GetLigand(ctx)
-		defer i.organ.materialPool.PutLigand(ligand)
-		if ligand.inflammation >= LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD {
-			return true
-		}
-	case CellType_VirginTLymphocyte:
-		return len(i.mhc_ii.presented) > 0
-	case CellType_KillerTLymphocyte:
-		fallthrough
-	case CellType_HelperTLymphocyte:
-		hormone := i.organ.materialPool.GetHormone(ctx)
-		defer i.organ.materialPool.PutHormone(hormone)
-		if hormone.interleukin_2 >= HORMONE_IL2_THRESHOLD {
-			hormone.interleukin_2 -= HORMONE_IL2_THRESHOLD
-			return true
-		}
-	case CellType_BLymphocyte:
-		return len(i.mhc_ii.presented) > 0
+	if willMitosis := i.Spec().WillMitosis; willMitosis != nil {
+		return willMitosis(ctx, i.mitoticCell())
 	}
 	// All other leukocytes don't differentiate.
 	return false
 }
 
 func (i *Leukocyte) Mitosis(ctx context.Context) bool {
-	// https://en.wikipedia.org/wiki/Metamyelocyte#/media/File:Hematopoiesis_(human)_diagram_en.svg
-	switch i.cellType {
-	case CellType_Lymphoblast:
-		// Can differentiate into Natural Killer, B Cell, and T Cells.
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_NaturalKillerCell, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.proteins)
-		// After differentiating, the existing cell will be converted to another, so clean up the existing one.
-		return false
-	case CellType_Myeloblast:
-		// Can differentiate into Neutrophil.
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_Neutrocyte, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.proteins)
-		// After differentiating, the existing cell will be converted to another, so clean up the existing one.
-		return false
-	case CellType_Monocyte:
-		// Can differentiate into Macrophage and Dendritic cells.
-		// There are specific conditions that detmermine whether to
-		// differentiate into a macrophage or dendritic cell. In this case, we
-		// flip a coin.
-		if rand.Intn(2) == 0 {
-			MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_Macrophagocyte, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.proteins)
-		} else {
-			MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_Dendritic, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.proteins)
-		}
-		// After differentiating, the existing cell will be converted to another, so clean up the existing one.
-		return false
-	case CellType_VirginTLymphocyte:
-		// Split T Cell into Helper and Killer T Cells. Randomly decide if a Helper T cell should go to the battle field or to find a B cell.
-		helperWantPath := i.wantPath
-		if rand.Intn(2) == 0 {
-			helperWantPath = [10]string{}
-		}
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_HelperTLymphocyte, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, helperWantPath, i.mhc_ii.presented)
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_KillerTLymphocyte, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.presented)
-		// Deactivate after mitosis.
-		i.mhc_ii.ClearPresented()
-		// Keep the original Virgin T Cell.
-		return true
-	case CellType_KillerTLymphocyte:
-		fallthrough
-	case CellType_HelperTLymphocyte:
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, i.cellType, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.proteins)
-		return true
-	case CellType_BLymphocyte:
-		// Split B cell into the original B cell and an Effector B cell.
-		MakeTransportRequest(i.organ.transportUrl, i.dna.name, i.dna, CellType_EffectorBLymphocyte, WorkType_nothing, string(i.render.id), time.Now(), i.transportPath, i.wantPath, i.mhc_ii.presented)
-		// Deactivate after mitosis.
-		i.mhc_ii.ClearPresented()
-		// Keep the original B Cell.
-		return true
+	if mitosis := i.Spec().Mitosis; mitosis != nil {
+		return mitosis(ctx, i.mitoticCell())
 	}
 	return true
 }
 
+// The cell type's own mitosis may need more of the cell than a Leukocyte,
+// like the antibody a B cell makes.
+func (i *Leukocyte) mitoticCell() MitoticCell {
+	if i.actor != nil {
+		return i.actor
+	}
+	return i
+}
+
 func (i *Leukocyte) IncreaseInflammation() {
 	i.Organ().materialPool.PutLigand(&LigandBlob{
 		inflammation: LIGAND_INFLAMMATION_LEUKOCYTE,
@@ -1313,7 +1206,7 @@ func (i *Leukocyte) IncreaseInflammation() {
 func (i *Leukocyte) SampleProteins(ctx context.Context, shouldPresent bool) (proteins []Protein, foundSelf bool, foundOther bool) {
 	// Sample proteins for presentation.
 	proteins = i.Organ().antigenPool.SampleProteins(ctx, PROTEIN_SAMPLE_DURATION, PROTEIN_MAX_SAMPLES)
-	proteins = append(proteins, i.Organ().antigenPool.SampleVirusProteins(VIRUS_SAMPLE_RATE)...)
+	proteins = append(proteins, i.Organ().antigenPool.SampleVirusProteins(i.Rand(), VIRUS_SAMPLE_RATE)...)
 
 	foundSelf = false
 	foundOther = false
@@ -1350,24 +1243,18 @@ func (i *Leukocyte) SampleAntigen(a *Antigen, shouldPresent bool) (proteins []Pr
 	return
 }
 
-func (i *Leukocyte) Execute(c CellActor) {
-	// Gracefully destroy cell without releasing viral load.
-	c.Apoptosis(false)
-	fmt.Println(i, "hit the kill switch on", c)
+// Kill destroys the cell without releasing its viral load, and credits the kill
+// to this cell's lineage.
+func (i *Leukocyte) Kill(c CellActor, cause ApoptosisCause) {
+	if i.organ != nil {
+		i.organ.lineage.Kill(i.lineage.ID, c.Lineage().ID)
+	}
+	c.Apoptosis(false, cause)
 }
 
-func (i *Leukocyte) WantEdgeType() []EdgeType {
-	switch i.cellType {
-	case CellType_Dendritic:
-		if len(i.mhc_ii.presented) > 0 {
-			return []EdgeType{lymphatic}
-		}
-	case CellType_VirginTLymphocyte:
-		return []EdgeType{lymphatic}
-	case CellType_HelperTLymphocyte:
-		return []EdgeType{skeletal}
-	}
-	return nil
+func (i *Leukocyte) Execute(c CellActor) {
+	// Gracefully destroy cell without releasing viral load.
+	i.Kill(c, KILL_SWITCH_CAUSE)
 }
 
 type LeukocyteStemCell struct {
@@ -1378,12 +1265,8 @@ func (l *LeukocyteStemCell) CanRepair() bool {
 	return false
 }
 
-func (l *LeukocyteStemCell) CanInteract() bool {
-	return false
-}
-
 func (l *LeukocyteStemCell) Start(ctx context.Context) {
-	l.function = l.dna.makeFunction(l, l.dna)
+	l.MakeFunction(l)
 	go l.function.Run(ctx, l)
 	l.Tissue().Attach(l.render)
 }
@@ -1392,47 +1275,6 @@ func (l *LeukocyteStemCell) BroadcastExistence(ctx context.Context) {
 	BroadcastExistence(ctx, l)
 }
 
-func CopyLeukocyteStemCell(base *LeukocyteStemCell) *LeukocyteStemCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &LeukocyteStemCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
-	}
-}
-
 type Neutrophil struct {
 	inNETosis bool
 	*Leukocyte
@@ -1442,20 +1284,27 @@ func (n *Neutrophil) ShouldIncurDamage(ctx context.Context) bool {
 	return n.Leukocyte.ShouldIncurDamage(ctx) || n.inNETosis
 }
 
+func (n *Neutrophil) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := n.Leukocyte.Snapshot(dnas)
+	snapshot.InNetosis = n.inNETosis
+	return snapshot
+}
+
+func (n *Neutrophil) Restore(snapshot *CellSnapshot) {
+	n.Leukocyte.Restore(snapshot)
+	n.inNETosis = snapshot.InNetosis
+}
+
 func (n *Neutrophil) CanRepair() bool {
 	return false
 }
 
 func (n *Neutrophil) Start(ctx context.Context) {
-	n.function = n.dna.makeFunction(n, n.dna)
+	n.MakeFunction(n)
 	go n.function.Run(ctx, n)
 	n.Tissue().Attach(n.render)
 }
 
-func (n *Neutrophil) DoesWork() bool {
-	return true
-}
-
 func (n *Neutrophil) DoWork(ctx context.Context) {
 	// https://www.ncbi.nlm.nih.gov/pmc/articles/PMC8589350
 	_, _, foundOther := n.SampleProteins(ctx, true)
@@ -1477,8 +1326,7 @@ func (n *Neutrophil) Interact(ctx context.Context, c CellActor) {
 	// There are three modes of killing for neutrophils:
 	//  1 - Phagocytosis: If the bacteria is covered opsonins (complements
 	//      bound to parts of the bacteria) or antibodies, then the neutrophil
-	//      can consume it. We won't have complements, so we'll simulate that
-	//      with by checking if the bacteria has been around for some time.
+	//      can consume it.
 	//  2 - Degranulations: Release cytotoxic chemicals to cause damage.
 	//  3 - Neutrophil Extracellular Traps: Trap bacteria in a web of innards
 	//      made of DNA and toxins to keep them in place and cause damage. If
@@ -1487,8 +1335,8 @@ func (n *Neutrophil) Interact(ctx context.Context, c CellActor) {
 	antigenPresentConcentration := n.GetCytokineConcentrationAt(CytokineType_antigen_present, c.Position())
 	// Check if pathogen has been covered in antibodies.
 	hasAntibodies := c.AntibodyLoad() != nil && c.AntibodyLoad().concentration > 0
-	// Check if enough time has passed that the pathogen is covered in opsonins.
-	opsosonized := c.SpawnTime().Add(NEUTROPHIL_OPSONIN_TIME).Before(time.Now())
+	// Check if the pathogen has been covered in opsonins.
+	opsonized := c.Opsonins() >= COMPLEMENT_OPSONIN_THRESHOLD
 	if !n.inNETosis && (antigenPresentConcentration >= NEUTROPHIL_NETOSIS_THRESHOLD ||
 		n.TimeLeft() < NEUTROPHIL_LIFE_SPAN/3) {
 		n.inNETosis = true
@@ -1498,57 +1346,16 @@ func (n *Neutrophil) Interact(ctx context.Context, c CellActor) {
 	if n.inNETosis {
 		n.Trap(c)
 		c.IncurDamage(NEUTROPHIL_NET_DAMAGE)
-	} else if opsosonized || hasAntibodies {
+	} else if opsonized || hasAntibodies {
 		// Can perform phagocytosis without NET, which is insta kill.
 		n.Trap(c)
-		c.Apoptosis(false)
+		n.Kill(c, PHAGOCYTOSIS_CAUSE)
 	} else {
 		n.DropCytokine(CytokineType_cytotoxins, CYTOKINE_CYTOTOXINS)
 	}
 	n.IncreaseInflammation()
 }
 
-func CopyNeutrophil(base *Neutrophil) *Neutrophil {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &Neutrophil{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
-	}
-}
-
 type MacrophageMode int
 
 type Macrophage struct {
@@ -1556,12 +1363,39 @@ type Macrophage struct {
 	activationTime time.Time
 }
 
+func (m *Macrophage) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := m.Leukocyte.Snapshot(dnas)
+	if !m.activationTime.IsZero() {
+		snapshot.ActivationTime = m.activationTime.UnixNano()
+	}
+	return snapshot
+}
+
+func (m *Macrophage) Restore(snapshot *CellSnapshot) {
+	m.Leukocyte.Restore(snapshot)
+	if snapshot.ActivationTime != 0 {
+		m.activationTime = time.Unix(0, snapshot.ActivationTime)
+	}
+}
+
 func (m *Macrophage) IsActivated() bool {
-	return time.Until(m.activationTime.Add(MACROPHAGE_ACTIVATION_COOLDOWN)) > 0
+	return m.Clock().Until(m.activationTime.Add(MACROPHAGE_ACTIVATION_COOLDOWN)) > 0
+}
+
+// Activation lasts for MACROPHAGE_ACTIVATION_COOLDOWN, unless anti-inflammatory
+// cytokines hold it back.
+func (m *Macrophage) ShouldActivate(event Event) {
+	if m.IsSuppressed() {
+		return
+	}
+	if !m.IsActivated() {
+		m.LogEvent(event)
+	}
+	m.activationTime = m.Clock().Now()
 }
 
 func (m *Macrophage) Start(ctx context.Context) {
-	m.function = m.dna.makeFunction(m, m.dna)
+	m.MakeFunction(m)
 	go m.function.Run(ctx, m)
 	m.Tissue().Attach(m.render)
 }
@@ -1570,14 +1404,9 @@ func (m *Macrophage) BroadcastExistence(ctx context.Context) {
 	BroadcastExistence(ctx, m)
 }
 
-func (m *Macrophage) DoesWork() bool {
-	return true
-}
-
 func (m *Macrophage) DoWork(ctx context.Context) {
-	foundCytokine := m.FoundAntigenCytokine()
-	if foundCytokine {
-		m.activationTime = time.Now()
+	if m.FoundAntigenCytokine() {
+		m.ShouldActivate(Event{Type: ACTIVATION_EVENT, Detail: "antigen present cytokine"})
 	}
 	_, foundSelf, foundOther := m.SampleProteins(ctx, true)
 	if m.IsActivated() {
@@ -1600,6 +1429,18 @@ func (m *Macrophage) DoWork(ctx context.Context) {
 			growth: MACROPHAGE_STIMULATE_CELL_GROWTH,
 		})
 	}
+	if InSpleen(m.Organ()) {
+		// Filter the blood of virus that antibodies marked, and retire an
+		// aged red blood cell.
+		m.Organ().antigenPool.FilterOpsonizedViralLoad(MACROPHAGE_VIRAL_FILTER_RATE)
+		for _, c := range m.Organ().Cells() {
+			if c.CellType() == CellType_RedBlood && !c.IsApoptosis() && Senescent(c) {
+				m.Trap(c)
+				m.Kill(c, PHAGOCYTOSIS_CAUSE)
+				break
+			}
+		}
+	}
 }
 
 func (m *Macrophage) Interact(ctx context.Context, c CellActor) {
@@ -1612,13 +1453,14 @@ func (m *Macrophage) Interact(ctx context.Context, c CellActor) {
 	// Macrophage have one basic attack: phagocytosis. But when no pathogen is
 	// present, they have to suppress the inflammation response.
 	// However, in order to become potent killers, they must be activated by
-	// a cytokines produced by other immune cells.
+	// a cytokines produced by other immune cells. Bacteria covered in
+	// opsonins are engulfed regardless.
 	// https://www.ncbi.nlm.nih.gov/pmc/articles/PMC2724991/
 	// Phagocytosis.
-	if m.IsActivated() {
+	if m.IsActivated() || c.Opsonins() >= COMPLEMENT_OPSONIN_THRESHOLD {
 		// It's bacteria, time to kill.
 		m.Trap(c)
-		c.Apoptosis(false)
+		m.Kill(c, PHAGOCYTOSIS_CAUSE)
 		// Pick up protein signatures for presentation.
 		m.mhc_ii.SetProteins(antigen.proteins)
 		m.DropCytokine(CytokineType_antigen_present, CYTOKINE_ANTIGEN_PRESENT)
@@ -1626,53 +1468,12 @@ func (m *Macrophage) Interact(ctx context.Context, c CellActor) {
 	}
 }
 
-func CopyMacrophage(base *Macrophage) *Macrophage {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &Macrophage{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
-	}
-}
-
 type NaturalKiller struct {
 	*Leukocyte
 }
 
 func (n *NaturalKiller) Start(ctx context.Context) {
-	n.function = n.dna.makeFunction(n, n.dna)
+	n.MakeFunction(n)
 	go n.function.Run(ctx, n)
 	n.Tissue().Attach(n.render)
 }
@@ -1698,53 +1499,12 @@ func (n *NaturalKiller) Interact(ctx context.Context, c CellActor) {
 	}
 }
 
-func CopyNaturalKiller(base *NaturalKiller) *NaturalKiller {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &NaturalKiller{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
-	}
-}
-
 type DendriticCell struct {
 	*Leukocyte
 }
 
 func (d *DendriticCell) Start(ctx context.Context) {
-	d.function = d.dna.makeFunction(d, d.dna)
+	d.MakeFunction(d)
 	go d.function.Run(ctx, d)
 	d.Tissue().Attach(d.render)
 }
@@ -1757,8 +1517,24 @@ func (d *DendriticCell) ShouldIncurDamage(ctx context.Context) bool {
 	return d.Leukocyte.ShouldIncurDamage(ctx) || len(d.mhc_ii.presented) > 0
 }
 
-func (d *DendriticCell) DoesWork() bool {
-	return true
+func (d *DendriticCell) ShouldTransport(ctx context.Context) bool {
+	if d.render.followId != "" {
+		return false
+	}
+	if d.TimeToTransport() > 0 || d.organ == nil {
+		return false
+	}
+	// If the dendritic cell was activated, it should not transport,
+	// and opt to die instead: https://www.ncbi.nlm.nih.gov/pmc/articles/PMC3282617/
+	return len(d.mhc_ii.presented) == 0
+}
+
+// Once presenting, head for the lymph nodes to find Virgin T Cells.
+func (d *DendriticCell) WantEdgeType() []EdgeType {
+	if len(d.mhc_ii.presented) > 0 {
+		return []EdgeType{lymphatic}
+	}
+	return nil
 }
 
 func (d *DendriticCell) DoWork(ctx context.Context) {
@@ -1779,7 +1555,7 @@ func (d *DendriticCell) Interact(ctx context.Context, c CellActor) {
 		d.DropCytokine(CytokineType_antigen_present, CYTOKINE_ANTIGEN_PRESENT)
 		d.IncreaseInflammation()
 		if c.Damage() >= DENDRITIC_PHAGOCYTOSIS_DAMAGE_TRESHOLD {
-			c.Apoptosis(false)
+			d.Kill(c, PHAGOCYTOSIS_CAUSE)
 			d.SampleAntigen(antigen, true)
 		}
 		return
@@ -1794,53 +1570,28 @@ func (d *DendriticCell) Interact(ctx context.Context, c CellActor) {
 	}
 }
 
-func CopyDendriticCell(base *DendriticCell) *DendriticCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &DendriticCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
-	}
-}
-
 type VirginTCell struct {
 	*Leukocyte
+	memory CloneMemory
+}
+
+func (t *VirginTCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := t.Leukocyte.Snapshot(dnas)
+	t.memory.Snapshot(snapshot)
+	return snapshot
+}
+
+func (t *VirginTCell) Restore(snapshot *CellSnapshot) {
+	t.Leukocyte.Restore(snapshot)
+	t.memory.Restore(snapshot)
+}
+
+func (t *VirginTCell) CloneMemory() *CloneMemory {
+	return &t.memory
 }
 
 func (t *VirginTCell) Start(ctx context.Context) {
-	t.function = t.dna.makeFunction(t, t.dna)
+	t.MakeFunction(t)
 	go t.function.Run(ctx, t)
 	t.Tissue().Attach(t.render)
 }
@@ -1854,6 +1605,9 @@ func (t *VirginTCell) ShouldActivate(d *DendriticCell) {
 		// Dendritic Cell has already activated a T cell.
 		return
 	}
+	if t.IsSuppressed() {
+		return
+	}
 	for _, protein := range d.mhc_ii.GetProteins() {
 		if t.mhc_ii.Get(protein) {
 			t.mhc_ii.SetPresented([]Protein{protein})
@@ -1865,51 +1619,54 @@ func (t *VirginTCell) ShouldActivate(d *DendriticCell) {
 		t.Organ().materialPool.PutHormone(&HormoneBlob{
 			interleukin_2: HORMONE_TCELL_DROP,
 		})
-		if t.Verbose() {
-			fmt.Println("T Cell activated in ", t.organ)
-		}
+		t.LogEvent(Event{Type: ACTIVATION_EVENT, Target: string(d.render.id)})
 	}
 }
 
-func CopyVirginTCell(base *VirginTCell) *VirginTCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
+// Fresh out of the thymus, head for the lymph nodes.
+func (t *VirginTCell) ShouldTransport(ctx context.Context) bool {
+	if t.organ != nil && InThymus(t.organ) {
+		return t.render.followId == ""
 	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &VirginTCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
+	return t.Leukocyte.ShouldTransport(ctx)
+}
+
+type Thymocyte struct {
+	*Leukocyte
+	selections int
+}
+
+func (t *Thymocyte) Start(ctx context.Context) {
+	t.MakeFunction(t)
+	go t.function.Run(ctx, t)
+	t.Tissue().Attach(t.render)
+}
+
+func (t *Thymocyte) BroadcastExistence(ctx context.Context) {
+	BroadcastExistence(ctx, t)
+}
+
+// Thymic epithelial cells present self proteins. A thymocyte that recognizes
+// them as self is positively selected, one that binds them dies.
+func (t *Thymocyte) Interact(ctx context.Context, c CellActor) {
+	if t.organ == nil || c.CellType() != CellType_ThymicEpithelial {
+		return
+	}
+	antigen := c.PresentAntigen()
+	if !t.VerifySelf(antigen) {
+		return
+	}
+	if t.IsAntigen(antigen) {
+		t.Apoptosis(false, NEGATIVE_SELECTION_CAUSE)
+		return
 	}
+	t.selections++
+}
+
+// Thymocytes that never get selected die of neglect at the end of their life
+// span.
+func (t *Thymocyte) WillMitosis(ctx context.Context) bool {
+	return t.selections >= THYMOCYTE_SELECTIONS
 }
 
 type HelperTCell struct {
@@ -1917,7 +1674,7 @@ type HelperTCell struct {
 }
 
 func (t *HelperTCell) Start(ctx context.Context) {
-	t.function = t.dna.makeFunction(t, t.dna)
+	t.MakeFunction(t)
 	go t.function.Run(ctx, t)
 	t.Tissue().Attach(t.render)
 }
@@ -1926,10 +1683,6 @@ func (t *HelperTCell) BroadcastExistence(ctx context.Context) {
 	BroadcastExistence(ctx, t)
 }
 
-func (t *HelperTCell) DoesWork() bool {
-	return true
-}
-
 func (t *HelperTCell) DoWork(ctx context.Context) {
 	// Looking for B Cells to present to, so draw them closer.
 	t.DropCytokine(CytokineType_induce_chemotaxis, CYTOKINE_CHEMO_TAXIS)
@@ -1947,53 +1700,21 @@ func (t *HelperTCell) Interact(ctx context.Context, c CellActor) {
 	case CellType_Macrophagocyte:
 		if m, ok := c.(*Macrophage); ok {
 			m.mhc_ii.SetPresented(t.mhc_ii.GetProteins())
-			m.activationTime = time.Now()
+			m.ShouldActivate(Event{Type: ACTIVATION_EVENT, Target: string(t.render.id)})
 		}
 	case CellType_BLymphocyte:
 		if b, ok := c.(*BCell); ok {
 			b.ShouldActivate(t)
 		}
-	}
-}
-
-func CopyHelperTCell(base *HelperTCell) *HelperTCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &HelperTCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
+	case CellType_MemoryBLymphocyte:
+		if b, ok := c.(*MemoryBCell); ok && b.ShouldActivate(t.mhc_ii.GetProteins(), string(t.render.id)) {
+			b.immunoglobulin.ClassSwitch(IsotypeSignal(t.Organ()))
+		}
+	case CellType_EffectorBLymphocyte:
+		// Effector B Cells still making IgM switch wherever they meet one.
+		if b, ok := c.(*EffectorBCell); ok {
+			b.immunoglobulin.ClassSwitch(IsotypeSignal(t.Organ()))
+		}
 	}
 }
 
@@ -2002,7 +1723,7 @@ type KillerTCell struct {
 }
 
 func (t *KillerTCell) Start(ctx context.Context) {
-	t.function = t.dna.makeFunction(t, t.dna)
+	t.MakeFunction(t)
 	go t.function.Run(ctx, t)
 	t.Tissue().Attach(t.render)
 }
@@ -2026,53 +1747,146 @@ func (t *KillerTCell) Interact(ctx context.Context, c CellActor) {
 	}
 }
 
-func CopyKillerTCell(base *KillerTCell) *KillerTCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
+// Regulatory T Cells keep an immune response from running away: they soak up
+// the IL-2 that Helper and Killer T Cells divide on, and secrete IL-10 and
+// anti-inflammatory cytokines that keep T Cells and Macrophages around them
+// from activating, so that the inflammation can resolve.
+type RegulatoryTCell struct {
+	*Leukocyte
+}
+
+func (t *RegulatoryTCell) Start(ctx context.Context) {
+	t.MakeFunction(t)
+	go t.function.Run(ctx, t)
+	t.Tissue().Attach(t.render)
+}
+
+func (t *RegulatoryTCell) BroadcastExistence(ctx context.Context) {
+	BroadcastExistence(ctx, t)
+}
+
+// IL-10 is only secreted on the IL-2 soaked up, so it doesn't outlast the
+// response it brakes.
+func (t *RegulatoryTCell) DoWork(ctx context.Context) {
+	hormone := t.Organ().materialPool.GetHormone(ctx)
+	if hormone.interleukin_2 > 0 {
+		if hormone.interleukin_2 > REGULATORY_TCELL_IL2_CONSUMPTION {
+			hormone.interleukin_2 -= REGULATORY_TCELL_IL2_CONSUMPTION
+		} else {
+			hormone.interleukin_2 = 0
+		}
+		hormone.interleukin_10 += HORMONE_IL10_DROP
 	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &KillerTCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
+	t.Organ().materialPool.PutHormone(hormone)
+	ligand := t.Organ().materialPool.GetLigand(ctx)
+	if ligand.inflammation > REGULATORY_TCELL_INFLAMMATION_CONSUMPTION {
+		ligand.inflammation -= REGULATORY_TCELL_INFLAMMATION_CONSUMPTION
+	} else {
+		ligand.inflammation = 0
+	}
+	t.Organ().materialPool.PutLigand(ligand)
+	t.DropCytokine(CytokineType_anti_inflammatory, CYTOKINE_ANTI_INFLAMMATORY)
+}
+
+// The antibody a B cell makes. It passes on to the Memory and Effector B
+// Cells the B cell divides into.
+type Immunoglobulin struct {
+	isotype      AntibodyIsotype
+	affinity     int32
+	mutationTime time.Time
+}
+
+func MakeImmunoglobulin() *Immunoglobulin {
+	return &Immunoglobulin{
+		isotype:  AntibodyIsotype_IgM,
+		affinity: ANTIBODY_BASE_AFFINITY,
+	}
+}
+
+func (i *Immunoglobulin) Snapshot(snapshot *CellSnapshot) {
+	snapshot.Isotype = i.isotype
+	snapshot.Affinity = i.affinity
+	if !i.mutationTime.IsZero() {
+		snapshot.MutationTime = i.mutationTime.UnixNano()
+	}
+}
+
+func (i *Immunoglobulin) Restore(snapshot *CellSnapshot) {
+	if snapshot.Affinity == 0 {
+		// Made before antibodies had affinities.
+		return
+	}
+	i.isotype = snapshot.Isotype
+	i.affinity = snapshot.Affinity
+	if snapshot.MutationTime != 0 {
+		i.mutationTime = time.Unix(0, snapshot.MutationTime)
+	}
+}
+
+func (i *Immunoglobulin) Secrete(protein Protein, concentration int64) *AntibodyLoad {
+	return &AntibodyLoad{
+		targetProtein: protein,
+		isotype:       i.isotype,
+		affinity:      i.affinity,
+		concentration: concentration,
+	}
+}
+
+// ClassSwitch changes the isotype the cell makes. The DNA for IgM is cut out
+// to switch, so there's no switching back.
+func (i *Immunoglobulin) ClassSwitch(isotype AntibodyIsotype) {
+	if isotype != AntibodyIsotype_IgM {
+		i.isotype = isotype
+	}
+}
+
+// Mutate simulates somatic hypermutation in a germinal center. B cells whose
+// mutations lower their affinity lose out on antigen to the rest and die off,
+// so only the mutations that raise it stick.
+func (i *Immunoglobulin) Mutate(random *rand.Rand, now time.Time) {
+	if i.mutationTime.IsZero() {
+		i.mutationTime = now
+	}
+	if now.Sub(i.mutationTime) < SOMATIC_HYPERMUTATION_INTERVAL {
+		return
+	}
+	i.mutationTime = now
+	affinity := i.affinity + random.Int31n(2*SOMATIC_HYPERMUTATION_STEP+1) - SOMATIC_HYPERMUTATION_STEP
+	if affinity > ANTIBODY_MAX_AFFINITY {
+		affinity = ANTIBODY_MAX_AFFINITY
+	}
+	if affinity > i.affinity {
+		i.affinity = affinity
+	}
+}
+
+// Cells that carry the antibody of the B cell they came from.
+type AntibodyProducing interface {
+	Immunoglobulin() *Immunoglobulin
+}
+
+// Helper T Cells signal B cells to switch to IgA in the gut, where it is
+// secreted across the lining, and to IgE in the skin. Everywhere else, they
+// signal IgG.
+func IsotypeSignal(o *Node) AntibodyIsotype {
+	switch {
+	case InGut(o):
+		return AntibodyIsotype_IgA
+	case o != nil && o.organ == "skin":
+		return AntibodyIsotype_IgE
+	default:
+		return AntibodyIsotype_IgG
 	}
 }
 
 type BCell struct {
 	*Leukocyte
+	immunoglobulin *Immunoglobulin
+	memory         CloneMemory
 }
 
 func (b *BCell) Start(ctx context.Context) {
-	b.function = b.dna.makeFunction(b, b.dna)
+	b.MakeFunction(b)
 	go b.function.Run(ctx, b)
 	b.Tissue().Attach(b.render)
 }
@@ -2081,6 +1895,29 @@ func (b *BCell) BroadcastExistence(ctx context.Context) {
 	BroadcastExistence(ctx, b)
 }
 
+func (b *BCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := b.Leukocyte.Snapshot(dnas)
+	b.immunoglobulin.Snapshot(snapshot)
+	b.memory.Snapshot(snapshot)
+	return snapshot
+}
+
+func (b *BCell) Restore(snapshot *CellSnapshot) {
+	b.Leukocyte.Restore(snapshot)
+	b.immunoglobulin.Restore(snapshot)
+	b.memory.Restore(snapshot)
+}
+
+func (b *BCell) CloneMemory() *CloneMemory {
+	return &b.memory
+}
+
+func (b *BCell) Immunoglobulin() *Immunoglobulin {
+	return b.immunoglobulin
+}
+
+// The Helper T Cell that activates the B cell also signals which isotype its
+// Memory B Cell should switch to.
 func (b *BCell) ShouldActivate(t *HelperTCell) {
 	if len(t.mhc_ii.presented) > 0 {
 		// Helper T Cell has already activated a B cell.
@@ -2092,58 +1929,183 @@ func (b *BCell) ShouldActivate(t *HelperTCell) {
 			t.mhc_ii.SetPresented([]Protein{protein})
 		}
 	}
-	if b.Verbose() && len(b.mhc_ii.presented) > 0 {
-		fmt.Println("B Cell activated in ", t.organ)
+	if len(b.mhc_ii.presented) > 0 {
+		b.immunoglobulin.ClassSwitch(IsotypeSignal(t.Organ()))
+		b.LogEvent(Event{Type: ACTIVATION_EVENT, Target: string(t.render.id)})
 	}
 }
 
-func CopyBCell(base *BCell) *BCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
+// A naive lymphocyte leaves behind a memory cell when it divides, but only so
+// many however often it is activated, since memory cells live for a year.
+type CloneMemory struct {
+	memoryCells int
+}
+
+type RememberingCell interface {
+	CloneMemory() *CloneMemory
+}
+
+// Remember counts another memory cell, unless the clone has left enough.
+func (m *CloneMemory) Remember() bool {
+	if m.memoryCells >= MEMORY_CELLS_PER_CLONE {
+		return false
 	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &BCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
+	m.memoryCells++
+	return true
+}
+
+func (m *CloneMemory) Snapshot(snapshot *CellSnapshot) {
+	snapshot.MemoryCells = int32(m.memoryCells)
+}
+
+func (m *CloneMemory) Restore(snapshot *CellSnapshot) {
+	m.memoryCells = int(snapshot.MemoryCells)
+}
+
+// Memory cells rest once made or reactivated, rather than reactivate on the
+// same antigen presenting cell over and over.
+type MemoryCell struct {
+	*Leukocyte
+	activationTime time.Time
+}
+
+func (m *MemoryCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := m.Leukocyte.Snapshot(dnas)
+	if !m.activationTime.IsZero() {
+		snapshot.ActivationTime = m.activationTime.UnixNano()
+	}
+	return snapshot
+}
+
+func (m *MemoryCell) Restore(snapshot *CellSnapshot) {
+	m.Leukocyte.Restore(snapshot)
+	if snapshot.ActivationTime != 0 {
+		m.activationTime = time.Unix(0, snapshot.ActivationTime)
+	}
+}
+
+func (m *MemoryCell) IsResting() bool {
+	since := m.spawnTime
+	if m.activationTime.After(since) {
+		since = m.activationTime
+	}
+	return m.Clock().Until(since.Add(MEMORY_CELL_REST)) > 0
+}
+
+// Activate presents whichever of the proteins the cell remembers, unless it
+// is resting or already activated.
+func (m *MemoryCell) Activate(proteins []Protein) bool {
+	if len(m.mhc_ii.presented) > 0 || m.IsResting() {
+		return false
+	}
+	for _, protein := range proteins {
+		if m.mhc_ii.Get(protein) {
+			m.mhc_ii.SetPresented([]Protein{protein})
+		}
+	}
+	if len(m.mhc_ii.presented) == 0 {
+		return false
+	}
+	m.activationTime = m.Clock().Now()
+	return true
+}
+
+type MemoryTCell struct {
+	*MemoryCell
+}
+
+func (t *MemoryTCell) Start(ctx context.Context) {
+	t.MakeFunction(t)
+	go t.function.Run(ctx, t)
+	t.Tissue().Attach(t.render)
+}
+
+func (t *MemoryTCell) BroadcastExistence(ctx context.Context) {
+	BroadcastExistence(ctx, t)
+}
+
+// Unlike a Virgin T Cell, a Memory T Cell doesn't need a dendritic cell that
+// has yet to activate anyone: any antigen presenting cell showing it its
+// antigen will do.
+func (t *MemoryTCell) ShouldActivate(apc CellActor) {
+	if t.IsSuppressed() || !t.Activate(apc.MHC_II().GetProteins()) {
+		return
+	}
+	t.wantPath = apc.TransportPath()
+	t.Organ().materialPool.PutHormone(&HormoneBlob{
+		interleukin_2: HORMONE_TCELL_DROP,
+	})
+	t.LogEvent(Event{Type: ACTIVATION_EVENT, Target: string(apc.Render().id)})
+}
+
+func (t *MemoryTCell) Interact(ctx context.Context, c CellActor) {
+	switch c.CellType() {
+	case CellType_Dendritic:
+		t.ShouldActivate(c)
+	case CellType_Macrophagocyte:
+		if m, ok := c.(*Macrophage); ok && m.IsActivated() {
+			t.ShouldActivate(c)
+		}
 	}
 }
 
+type MemoryBCell struct {
+	*MemoryCell
+	immunoglobulin *Immunoglobulin
+}
+
+func (b *MemoryBCell) Start(ctx context.Context) {
+	b.MakeFunction(b)
+	go b.function.Run(ctx, b)
+	b.Tissue().Attach(b.render)
+}
+
+func (b *MemoryBCell) BroadcastExistence(ctx context.Context) {
+	BroadcastExistence(ctx, b)
+}
+
+func (b *MemoryBCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := b.MemoryCell.Snapshot(dnas)
+	b.immunoglobulin.Snapshot(snapshot)
+	return snapshot
+}
+
+func (b *MemoryBCell) Restore(snapshot *CellSnapshot) {
+	b.MemoryCell.Restore(snapshot)
+	b.immunoglobulin.Restore(snapshot)
+}
+
+func (b *MemoryBCell) Immunoglobulin() *Immunoglobulin {
+	return b.immunoglobulin
+}
+
+// Memory B Cells reactivate on their antigen alone, whether a Helper T Cell
+// presents it or they come across it themselves.
+func (b *MemoryBCell) ShouldActivate(proteins []Protein, target string) bool {
+	if !b.Activate(proteins) {
+		return false
+	}
+	b.LogEvent(Event{Type: ACTIVATION_EVENT, Target: target})
+	return true
+}
+
+// While they wait in the lymph nodes, Memory B Cells keep refining their
+// antibody.
+func (b *MemoryBCell) DoWork(ctx context.Context) {
+	if InLymphNode(b.Organ()) {
+		b.immunoglobulin.Mutate(b.Rand(), b.Clock().Now())
+	}
+	proteins, _, _ := b.SampleProteins(ctx, false)
+	b.ShouldActivate(proteins, "")
+}
+
 type EffectorBCell struct {
 	*Leukocyte
+	immunoglobulin *Immunoglobulin
 }
 
 func (b *EffectorBCell) Start(ctx context.Context) {
-	b.function = b.dna.makeFunction(b, b.dna)
+	b.MakeFunction(b)
 	go b.function.Run(ctx, b)
 	b.Tissue().Attach(b.render)
 }
@@ -2152,138 +2114,69 @@ func (b *EffectorBCell) BroadcastExistence(ctx context.Context) {
 	BroadcastExistence(ctx, b)
 }
 
-func (b *EffectorBCell) DoesWork() bool {
-	return true
+func (b *EffectorBCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := b.Leukocyte.Snapshot(dnas)
+	b.immunoglobulin.Snapshot(snapshot)
+	return snapshot
 }
 
-func (b *EffectorBCell) DoWork(ctx context.Context) {
-	for _, protein := range b.mhc_ii.GetProteins() {
-		b.organ.antigenPool.DepositAntibodyLoad(&AntibodyLoad{
-			targetProtein: protein,
-			concentration: EFFECTOR_BCELL_ANTIBODY_PRODUCTION,
-		})
-	}
+func (b *EffectorBCell) Restore(snapshot *CellSnapshot) {
+	b.Leukocyte.Restore(snapshot)
+	b.immunoglobulin.Restore(snapshot)
 }
 
-func CopyEffectorBCell(base *EffectorBCell) *EffectorBCell {
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
+func (b *EffectorBCell) Immunoglobulin() *Immunoglobulin {
+	return b.immunoglobulin
+}
+
+// Effector B Cells in the germinal centers of the lymph nodes keep refining
+// their antibody too, so the first response matures as it goes on.
+func (b *EffectorBCell) DoWork(ctx context.Context) {
+	if InLymphNode(b.Organ()) {
+		b.immunoglobulin.Mutate(b.Rand(), b.Clock().Now())
 	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &EffectorBCell{
-		Leukocyte: &Leukocyte{
-			Cell: &Cell{
-				cellType: base.cellType,
-				dna:      base.dna,
-				mhc_i:    base.dna.MHC_I(),
-				workType: base.workType,
-				render: &Renderable{
-					id:            MakeRenderId(base.cellType.String()),
-					visible:       true,
-					position:      position,
-					targetX:       base.render.targetX,
-					targetY:       base.render.targetY,
-					targetZ:       base.render.targetZ,
-					lastPositions: positionTracker,
-					renderType: RenderType{
-						Type: &RenderType_CellType{
-							CellType: base.cellType,
-						},
-					},
-				},
-				transportPath: base.transportPath,
-				wantPath:      base.wantPath,
-				spawnTime:     base.spawnTime,
-				transportTime: base.transportTime,
-				cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-			},
-			lifeSpan:      base.lifeSpan,
-			transportSpan: base.transportSpan,
-			mhc_ii:        base.mhc_ii,
-		},
+	for _, protein := range b.mhc_ii.GetProteins() {
+		b.organ.antigenPool.DepositAntibodyLoad(b.immunoglobulin.Secrete(protein, EFFECTOR_BCELL_ANTIBODY_PRODUCTION))
+		b.LogEvent(Event{Type: ANTIBODY_EVENT, Target: fmt.Sprint(protein), Detail: fmt.Sprintf("%v %v, affinity %v", EFFECTOR_BCELL_ANTIBODY_PRODUCTION, b.immunoglobulin.isotype, b.immunoglobulin.affinity)})
 	}
 }
 
 type ProkaryoticCell struct {
 	*Cell
-	generationTime     time.Duration
 	lastGenerationTime time.Time
 	energy             int
 }
 
-func CopyProkaryoticCell(base *ProkaryoticCell) *ProkaryoticCell {
-	var generationTime time.Duration
-	switch base.cellType {
-	case CellType_Bacteroidota:
-		generationTime = GUT_BACTERIA_GENERATION_DURATION
-	default:
-		generationTime = DEFAULT_BACTERIA_GENERATION_DURATION
-	}
-	position := image.Point{
-		base.render.position.X,
-		base.render.position.Y,
-	}
-	positionTracker := ring.New(POSITION_TRACKER_SIZE)
-	positionTracker.Value = position
-	return &ProkaryoticCell{
-		Cell: &Cell{
-			cellType: base.cellType,
-			dna:      base.dna,
-			mhc_i:    base.dna.MHC_I(),
-			render: &Renderable{
-				id:            MakeRenderId(base.cellType.String()),
-				visible:       true,
-				position:      position,
-				targetX:       base.render.targetX,
-				targetY:       base.render.targetY,
-				targetZ:       base.render.targetZ,
-				lastPositions: positionTracker,
-				renderType: RenderType{
-					Type: &RenderType_CellType{
-						CellType: base.cellType,
-					},
-				},
-			},
-			transportPath: base.transportPath,
-			wantPath:      base.wantPath,
-			spawnTime:     base.spawnTime,
-			transportTime: base.transportTime,
-			cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-		},
-		generationTime:     generationTime,
-		lastGenerationTime: time.Now(),
-	}
-}
-
 func (p *ProkaryoticCell) Start(ctx context.Context) {
 	tissue := p.Tissue()
 	if tissue == nil {
 		return
 	}
-	p.function = p.dna.makeFunction(p, p.dna)
+	if p.lastGenerationTime.IsZero() {
+		p.lastGenerationTime = p.Clock().Now()
+	}
+	p.MakeFunction(p)
 	go p.function.Run(ctx, p)
 	tissue.Attach(p.render)
 }
 
-func (p *ProkaryoticCell) DoesWork() bool {
-	return false
+func (p *ProkaryoticCell) Snapshot(dnas *DNAIndex) *CellSnapshot {
+	snapshot := p.Cell.Snapshot(dnas)
+	snapshot.Energy = int32(p.energy)
+	snapshot.LastGenerationTime = p.lastGenerationTime.UnixNano()
+	return snapshot
 }
 
-func (p *ProkaryoticCell) CanTransport() bool {
-	return true
+func (p *ProkaryoticCell) Restore(snapshot *CellSnapshot) {
+	p.Cell.Restore(snapshot)
+	p.energy = int(snapshot.Energy)
+	if snapshot.LastGenerationTime != 0 {
+		p.lastGenerationTime = time.Unix(0, snapshot.LastGenerationTime)
+	}
 }
 
 func (p *ProkaryoticCell) TimeToTransport() time.Duration {
-	var transportDuration time.Duration
-	switch p.cellType {
-	case CellType_Bacteroidota:
-		transportDuration = GUT_BACTERIA_TRANSPORT_DURATION
-	default:
-		transportDuration = DEFAULT_BACTERIA_TRANSPORT_DURATION
-	}
-	return time.Until(p.transportTime.Add(transportDuration))
+	return p.Clock().Until(p.transportTime.Add(p.Spec().TransportSpan))
 }
 
 func (p *ProkaryoticCell) ShouldTransport(ctx context.Context) bool {
@@ -2293,15 +2186,6 @@ func (p *ProkaryoticCell) ShouldTransport(ctx context.Context) bool {
 	return p.TimeToTransport() < 0
 }
 
-func (p *ProkaryoticCell) WantEdgeType() []EdgeType {
-	switch p.cellType {
-	case CellType_Bacteroidota:
-		return []EdgeType{gut_lining}
-	default:
-		return []EdgeType{muscular}
-	}
-}
-
 func (p *ProkaryoticCell) CanRepair() bool {
 	return false
 }
@@ -2311,7 +2195,7 @@ func (p *ProkaryoticCell) BroadcastExistence(ctx context.Context) {
 }
 
 func (p *ProkaryoticCell) WillMitosis(context.Context) bool {
-	if time.Now().After(p.lastGenerationTime.Add(p.generationTime)) && p.energy >= BACTERIA_ENERGY_MITOSIS_THRESHOLD {
+	if p.Clock().Now().After(p.lastGenerationTime.Add(p.Spec().GenerationTime)) && p.energy >= BACTERIA_ENERGY_MITOSIS_THRESHOLD {
 		p.energy = 0
 		return true
 	}
@@ -2319,11 +2203,12 @@ func (p *ProkaryoticCell) WillMitosis(context.Context) bool {
 }
 
 func (p *ProkaryoticCell) Mitosis(ctx context.Context) bool {
-	p.lastGenerationTime = time.Now()
+	p.lastGenerationTime = p.Clock().Now()
 	if p.organ == nil {
 		return false
 	}
-	MakeTransportRequest(p.organ.transportUrl, p.dna.name, p.dna, p.cellType, WorkType_nothing, string(p.render.id), time.Now(), p.transportPath, p.wantPath, nil)
+	p.organ.MakeTransportRequest(p.organ.transportUrl, p.dna.name, p.dna, p.cellType, WorkType_nothing, string(p.render.id), p.Clock().Now(), p.transportPath, p.wantPath, nil, Lineage{Parent: p.lineage.ID}, p.Rand().Int63())
+	p.LogEvent(Event{Type: MITOSIS_EVENT})
 	return true
 }
 
@@ -2338,43 +2223,14 @@ func (p *ProkaryoticCell) CanMove() bool {
 	return true
 }
 
-func (p *ProkaryoticCell) IsAerobic() bool {
-	switch p.cellType {
-	case CellType_Bacteroidota:
-		return true
-	}
-	return false
-}
-
 type VirusCarrier struct {
 	*Cell
 	virus *Virus
 }
 
-func CopyViralLoadCarrier(base *VirusCarrier) *VirusCarrier {
-	return &VirusCarrier{
-		Cell: &Cell{
-			cellType:      base.cellType,
-			dna:           base.dna,
-			mhc_i:         base.dna.MHC_I(),
-			render:        &Renderable{},
-			transportPath: base.transportPath,
-			wantPath:      base.wantPath,
-			spawnTime:     base.spawnTime,
-			transportTime: base.transportTime,
-			cellActions:   ring.New(CELL_ACTIONS_BUFFER),
-		},
-		virus: &Virus{
-			dna:            base.dna,
-			targetCellType: base.GetTargetCellType(base.dna),
-			infectivity:    base.GetInfectivity(base.dna),
-		},
-	}
-}
-
 func (v *VirusCarrier) GetTargetCellType(dna *DNA) CellType {
-	cellType := CellType(int(dna.selfProteins[len(dna.selfProteins)-1]) % int(CellType_ViralLoadCarrier))
-	return cellType
+	infectable := InfectableCellTypes()
+	return infectable[int(dna.selfProteins[len(dna.selfProteins)-1])%len(infectable)]
 }
 
 func (v *VirusCarrier) GetInfectivity(dna *DNA) int64 {
@@ -2396,14 +2252,6 @@ func (v *VirusCarrier) BroadcastExistence(ctx context.Context) {
 	// Do nothing.
 }
 
-func (v *VirusCarrier) DoesWork() bool {
-	return false
-}
-
-func (v *VirusCarrier) IsAerobic() bool {
-	return false
-}
-
 func (v *VirusCarrier) WillMitosis(ctx context.Context) bool {
 	return false
 }
@@ -2412,7 +2260,13 @@ func (v *VirusCarrier) Mitosis(ctx context.Context) bool {
 	return false
 }
 
-func MakeCellFromType(cellType CellType, workType WorkType, dna *DNA, render *Renderable, spawnTime time.Time, transportPath [10]string, wantPath [10]string, mhc_ii_proteins []Protein) (cell CellActor) {
+// MakeCellFromType places, names and seeds the cell from random, which the cell
+// keeps drawing from for the rest of its life.
+func MakeCellFromType(cellType CellType, workType WorkType, dna *DNA, render *Renderable, spawnTime time.Time, transportPath [10]string, wantPath [10]string, mhc_ii_proteins []Protein, random *rand.Rand) CellActor {
+	spec, ok := cellTypes[cellType]
+	if !ok {
+		panic(fmt.Sprintf("Unknown cell type: %v", cellType))
+	}
 	mhc_ii := &MHC_II{
 		proteins:  map[Protein]bool{},
 		presented: map[Protein]bool{},
@@ -2420,253 +2274,35 @@ func MakeCellFromType(cellType CellType, workType WorkType, dna *DNA, render *Re
 	for _, protein := range mhc_ii_proteins {
 		mhc_ii.proteins[protein] = true
 	}
-	switch cellType {
-	// Bacteria
-	case CellType_Bacteria:
-		fallthrough
-	case CellType_Bacteroidota:
-		cell = CopyProkaryoticCell(&ProkaryoticCell{
-			Cell: &Cell{
-				cellType:      cellType,
-				dna:           dna,
-				render:        render,
-				transportPath: transportPath,
-				wantPath:      wantPath,
-				spawnTime:     spawnTime,
-				transportTime: time.Now(),
-			},
-		})
-	// Viral Load
-	case CellType_ViralLoadCarrier:
-		return CopyViralLoadCarrier(&VirusCarrier{
-			Cell: &Cell{
-				cellType:      cellType,
-				dna:           dna,
-				workType:      workType,
-				render:        render,
-				transportPath: transportPath,
-				wantPath:      wantPath,
-				spawnTime:     spawnTime,
-				transportTime: time.Now(),
-			},
-			virus: &Virus{},
-		})
-	// Leukocytes
-	case CellType_Lymphoblast:
-		fallthrough
-	case CellType_Myeloblast:
-		fallthrough
-	case CellType_Monocyte:
-		cell = CopyLeukocyteStemCell(&LeukocyteStemCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      LEUKOCYTE_STEM_CELL_LIFE_SPAN,
-				transportSpan: LEUKOCYTE_STEM_CELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_Macrophagocyte:
-		cell = CopyMacrophage(&Macrophage{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      MACROPHAGE_LIFE_SPAN,
-				transportSpan: MACROPHAGE_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_Dendritic:
-		cell = CopyDendriticCell(&DendriticCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      DENDRITIC_CELL_LIFE_SPAN,
-				transportSpan: DENDRITIC_CELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_Neutrocyte:
-		cell = CopyNeutrophil(&Neutrophil{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      NEUTROPHIL_LIFE_SPAN,
-				transportSpan: NEUTROPHIL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_NaturalKillerCell:
-		cell = CopyNaturalKiller(&NaturalKiller{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      NATURALKILLER_LIFE_SPAN,
-				transportSpan: NATURALKILLER_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_VirginTLymphocyte:
-		cell = CopyVirginTCell(&VirginTCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      VIRGIN_TCELL_LIFE_SPAN,
-				transportSpan: VIRGIN_TCELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_HelperTLymphocyte:
-		cell = CopyHelperTCell(&HelperTCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      HELPER_TCELL_LIFE_SPAN,
-				transportSpan: HELPER_TCELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_KillerTLymphocyte:
-		cell = CopyKillerTCell(&KillerTCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      KILLER_TCELL_LIFE_SPAN,
-				transportSpan: KILLER_TCELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_BLymphocyte:
-		cell = CopyBCell(&BCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
-				},
-				lifeSpan:      BCELL_LIFE_SPAN,
-				transportSpan: BCELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
-			},
-		})
-	case CellType_EffectorBLymphocyte:
-		cell = CopyEffectorBCell(&EffectorBCell{
-			Leukocyte: &Leukocyte{
-				Cell: &Cell{
-					cellType:      cellType,
-					dna:           dna,
-					workType:      workType,
-					render:        render,
-					transportPath: transportPath,
-					wantPath:      wantPath,
-					spawnTime:     spawnTime,
-					transportTime: time.Now(),
+	position := image.Point{
+		render.position.X + RandInRange(random, -spec.SpawnDisplacement, spec.SpawnDisplacement),
+		render.position.Y + RandInRange(random, -spec.SpawnDisplacement, spec.SpawnDisplacement),
+	}
+	positionTracker := ring.New(POSITION_TRACKER_SIZE)
+	positionTracker.Value = position
+	return spec.Make(&Cell{
+		cellType: cellType,
+		dna:      dna,
+		mhc_i:    dna.MHC_I(),
+		workType: workType,
+		render: &Renderable{
+			id:            MakeRenderId(random, cellType.String()),
+			visible:       true,
+			position:      position,
+			targetX:       render.targetX,
+			targetY:       render.targetY,
+			targetZ:       render.targetZ,
+			lastPositions: positionTracker,
+			renderType: RenderType{
+				Type: &RenderType_CellType{
+					CellType: cellType,
 				},
-				lifeSpan:      EFFECTOR_BCELL_LIFE_SPAN,
-				transportSpan: EFFECTOR_BCELL_TRANSPORT_SPAN,
-				mhc_ii:        mhc_ii,
 			},
-		})
-	case CellType_RedBlood:
-		fallthrough
-	case CellType_Neuron:
-		fallthrough
-	case CellType_Cardiomyocyte:
-		fallthrough
-	case CellType_Pneumocyte:
-		fallthrough
-	case CellType_Myocyte:
-		fallthrough
-	case CellType_Keratinocyte:
-		fallthrough
-	case CellType_Enterocyte:
-		fallthrough
-	case CellType_Podocyte:
-		fallthrough
-	case CellType_Hemocytoblast:
-		cell = CopyEukaryoticCell(&EukaryoticCell{
-			Cell: &Cell{
-				cellType:      cellType,
-				dna:           dna,
-				workType:      workType,
-				render:        render,
-				transportPath: transportPath,
-				wantPath:      wantPath,
-				spawnTime:     spawnTime,
-				transportTime: time.Now(),
-			},
-		})
-	default:
-		panic(fmt.Sprintf("Unknown cell type: %v", cellType))
-	}
-	return
+		},
+		transportPath: transportPath,
+		wantPath:      wantPath,
+		spawnTime:     spawnTime,
+		cellActions:   ring.New(CELL_ACTIONS_BUFFER),
+		rand:          random,
+	}, mhc_ii)
 }
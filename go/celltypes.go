@@ -0,0 +1,780 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CellTypeSpec is everything that sets a cell type apart. Each type is
+// registered once, at init, instead of being spread across switches on the
+// cell type. Behavior that needs state of its own, like how a macrophage
+// interacts with other cells, lives on the Go type that Make wraps it in, and
+// what a cell does each tick is the program named after its type.
+type CellTypeSpec struct {
+	CellType CellType
+	DNAType  DNAType // What a generated cell of the type is made from.
+	// Make wraps a newly made cell in the Go type that implements the type.
+	// Leukocytes keep the MHC-II they were made with.
+	Make              func(cell *Cell, mhc_ii *MHC_II) CellActor
+	SpawnDisplacement int // How far from its parent a new cell is placed.
+	ResourceNeed      ResourceBlob
+	Aerobic           bool
+	InsulinDependent  bool // Takes up glucose only with insulin.
+	DoesWork          bool // Programs may only DoWork and Interact if the type can.
+	Infectable        bool // Viruses may target the type.
+	// Work is what the cell does when it works, besides collecting resources
+	// and producing waste.
+	Work           func(ctx context.Context, c *Cell)
+	ProduceWaste   func(c *Cell)
+	LifeSpan       time.Duration // Leukocytes, and red blood cells until retired.
+	TransportSpan  time.Duration // Time between transports, for leukocytes and bacteria.
+	GenerationTime time.Duration // Bacteria.
+	CanTransport   bool
+	CanInteract    bool
+	WantEdgeTypes  []EdgeType
+	// WillMitosis and Mitosis decide when a cell divides or differentiates,
+	// and into what. Mitosis returns false if the cell differentiated and
+	// should be cleaned up. Bacteria divide on their generation time instead.
+	WillMitosis func(ctx context.Context, c MitoticCell) bool
+	Mitosis     func(ctx context.Context, c MitoticCell) bool
+}
+
+// MitoticCell is what the mitosis rules of a cell type see of a cell.
+type MitoticCell interface {
+	CellType() CellType
+	Organ() *Node
+	DNA() *DNA
+	Render() *Renderable
+	Clock() *Clock
+	Rand() *rand.Rand
+	TransportPath() [10]string
+	WantPath() [10]string
+	MHC_II() *MHC_II
+	Lineage() Lineage
+	LogEvent(Event)
+}
+
+var cellTypes = map[CellType]*CellTypeSpec{}
+
+func RegisterCellType(spec *CellTypeSpec) {
+	if _, ok := cellTypes[spec.CellType]; ok {
+		panic(fmt.Sprintf("Cell type registered twice: %v", spec.CellType))
+	}
+	cellTypes[spec.CellType] = spec
+}
+
+// InfectableCellTypes lists the types viruses may target, in enum order.
+func InfectableCellTypes() (infectable []CellType) {
+	for cellType, spec := range cellTypes {
+		if spec.Infectable {
+			infectable = append(infectable, cellType)
+		}
+	}
+	sort.Slice(infectable, func(i, j int) bool {
+		return infectable[i] < infectable[j]
+	})
+	return
+}
+
+// LookupCellType returns an empty spec for a type that was never registered.
+func LookupCellType(cellType CellType) *CellTypeSpec {
+	if spec, ok := cellTypes[cellType]; ok {
+		return spec
+	}
+	return &CellTypeSpec{CellType: cellType}
+}
+
+func MakeEukaryoticCell(cell *Cell, mhc_ii *MHC_II) CellActor {
+	return &EukaryoticCell{Cell: cell}
+}
+
+func MakeLeukocyte(cell *Cell, mhc_ii *MHC_II) *Leukocyte {
+	return &Leukocyte{
+		Cell:   cell,
+		mhc_ii: mhc_ii,
+	}
+}
+
+// Spawn makes a cell of cellType next to c, in the same node.
+func Spawn(c MitoticCell, cellType CellType, wantPath [10]string, mhc_ii map[Protein]bool) {
+	o := c.Organ()
+	o.MakeTransportRequest(o.transportUrl, c.DNA().name, c.DNA(), cellType, WorkType_nothing, string(c.Render().id), c.Clock().Now(), c.TransportPath(), wantPath, mhc_ii, Lineage{Parent: c.Lineage().ID}, c.Rand().Int63())
+}
+
+// SpawnAntibodyProducer spawns a cell of the B cell lineage that makes the
+// given antibody. Returns false if the cell never arrived.
+func SpawnAntibodyProducer(c MitoticCell, cellType CellType, wantPath [10]string, mhc_ii map[Protein]bool, immunoglobulin *Immunoglobulin) bool {
+	o := c.Organ()
+	request := MakeSpawnRequest(c.DNA().name, c.DNA(), cellType, WorkType_nothing, string(c.Render().id), c.Clock().Now(), c.TransportPath(), wantPath, mhc_ii, Lineage{Parent: c.Lineage().ID}, c.Rand().Int63())
+	immunoglobulin.Snapshot(request.Cell)
+	err := o.transport.SendCell(o.transportUrl, request)
+	if err != nil {
+		fmt.Printf("Unable to spawn %v in %v: %v\n", cellType, o, err)
+		return false
+	}
+	return true
+}
+
+func WillMitosisOnGrowth(ctx context.Context, c MitoticCell) bool {
+	ligand := c.Organ().materialPool.GetLigand(ctx)
+	defer c.Organ().materialPool.PutLigand(ligand)
+	if ligand.growth >= LIGAND_GROWTH_THRESHOLD {
+		// Only checked if prior conditions are met.
+		ligand.growth -= LIGAND_GROWTH_THRESHOLD
+		return true
+	}
+	return false
+}
+
+// Bootstrap the mitosis function to spawn leukocyte stem cells, but only in
+// the presence of the hormone.
+func HemocytoblastWillMitosis(ctx context.Context, c MitoticCell) bool {
+	hormone := c.Organ().materialPool.GetHormone(ctx)
+	if hormone.granulocyte_csf >= HORMONE_CSF_THRESHOLD {
+		hormone.granulocyte_csf -= HORMONE_CSF_THRESHOLD
+		Spawn(c, CellType_Myeloblast, c.WantPath(), nil)
+		c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: CellType_Myeloblast.String()})
+	}
+	if hormone.macrophage_csf >= HORMONE_M_CSF_THRESHOLD {
+		hormone.macrophage_csf -= HORMONE_M_CSF_THRESHOLD
+		Spawn(c, CellType_Monocyte, c.WantPath(), nil)
+		c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: CellType_Monocyte.String()})
+	}
+	if hormone.interleukin_3 >= HORMONE_IL3_THRESHOLD {
+		hormone.interleukin_3 -= HORMONE_IL3_THRESHOLD
+		Spawn(c, CellType_Lymphoblast, c.WantPath(), nil)
+		c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: CellType_Lymphoblast.String()})
+	}
+	c.Organ().materialPool.PutHormone(hormone)
+	return WillMitosisOnGrowth(ctx, c)
+}
+
+// InThymus is whether the node is a thymus, whose epithelium selects T cells.
+func InThymus(o *Node) bool {
+	return o != nil && o.organ == "thymus"
+}
+
+// InSpleen is whether the node is a spleen, which filters the blood.
+func InSpleen(o *Node) bool {
+	return o != nil && o.organ == "spleen"
+}
+
+// InGut is whether the node is lined with gut, where IgA is secreted.
+func InGut(o *Node) bool {
+	return o != nil && o.organ == "gut"
+}
+
+// InLymphNode is whether the node is a lymph node, whose germinal centers
+// mature B cells.
+func InLymphNode(o *Node) bool {
+	return o != nil && o.organ == "lymph"
+}
+
+// Senescent is whether a cell that doesn't die of old age has outlived the
+// life span of its type.
+func Senescent(c CellActor) bool {
+	lifeSpan := LookupCellType(c.CellType()).LifeSpan
+	return lifeSpan > 0 && c.Clock().Until(c.SpawnTime().Add(lifeSpan)) < 0
+}
+
+// If there is no inflammation, don't differentiate.
+func WillDifferentiateOnInflammation(ctx context.Context, c MitoticCell) bool {
+	ligand := c.Organ().materialPool.GetLigand(ctx)
+	defer c.Organ().materialPool.PutLigand(ligand)
+	return ligand.inflammation >= LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD
+}
+
+func WillDifferentiateWhenPresented(ctx context.Context, c MitoticCell) bool {
+	return len(c.MHC_II().presented) > 0
+}
+
+// IL-10 from Regulatory T Cells brakes a division the IL-2 would allow, and
+// is bound up doing so.
+func WillMitosisOnInterleukin2(ctx context.Context, c MitoticCell) bool {
+	hormone := c.Organ().materialPool.GetHormone(ctx)
+	defer c.Organ().materialPool.PutHormone(hormone)
+	if hormone.interleukin_2 < HORMONE_IL2_THRESHOLD {
+		return false
+	}
+	if hormone.interleukin_10 >= HORMONE_IL10_THRESHOLD {
+		hormone.interleukin_10 -= HORMONE_IL10_BINDING
+		return false
+	}
+	hormone.interleukin_2 -= HORMONE_IL2_THRESHOLD
+	return true
+}
+
+// Differentiate replaces the cell with one of another type.
+// https://en.wikipedia.org/wiki/Metamyelocyte#/media/File:Hematopoiesis_(human)_diagram_en.svg
+func Differentiate(c MitoticCell, cellType CellType) bool {
+	Spawn(c, cellType, c.WantPath(), c.MHC_II().proteins)
+	c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: cellType.String()})
+	// After differentiating, the existing cell will be converted to another, so clean up the existing one.
+	return false
+}
+
+// Differentiates into a Thymocyte with a random receptor set in the thymus,
+// into a Natural Killer cell elsewhere.
+func LymphoblastMitosis(ctx context.Context, c MitoticCell) bool {
+	if !InThymus(c.Organ()) {
+		return Differentiate(c, CellType_NaturalKillerCell)
+	}
+	Spawn(c, CellType_Thymocyte, c.WantPath(), GenerateReceptors(c.Rand(), THYMOCYTE_RECEPTOR_COUNT))
+	c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: CellType_Thymocyte.String()})
+	return false
+}
+
+// Can differentiate into Macrophage and Dendritic cells. There are specific
+// conditions that detmermine whether to differentiate into a macrophage or
+// dendritic cell. In this case, we flip a coin.
+func MonocyteMitosis(ctx context.Context, c MitoticCell) bool {
+	differentiated := CellType_Macrophagocyte
+	if c.Rand().Intn(2) != 0 {
+		differentiated = CellType_Dendritic
+	}
+	return Differentiate(c, differentiated)
+}
+
+// Split T Cell into Helper and Killer T Cells. Randomly decide if a Helper T
+// cell should go to the battle field or to find a B cell. A Regulatory T Cell
+// follows the Killer T Cell, to rein in the response once it is underway.
+func VirginTCellMitosis(ctx context.Context, c MitoticCell) bool {
+	helperWantPath := c.WantPath()
+	if c.Rand().Intn(2) == 0 {
+		helperWantPath = [10]string{}
+	}
+	Spawn(c, CellType_HelperTLymphocyte, helperWantPath, c.MHC_II().presented)
+	Spawn(c, CellType_KillerTLymphocyte, c.WantPath(), c.MHC_II().presented)
+	Spawn(c, CellType_RegulatoryTLymphocyte, c.WantPath(), c.MHC_II().presented)
+	detail := fmt.Sprintf("%v, %v, %v", CellType_HelperTLymphocyte, CellType_KillerTLymphocyte, CellType_RegulatoryTLymphocyte)
+	// Leave behind a Memory T Cell for the next time the antigen comes around.
+	if c.(RememberingCell).CloneMemory().Remember() {
+		Spawn(c, CellType_MemoryTLymphocyte, [10]string{}, c.MHC_II().presented)
+		detail += fmt.Sprintf(", %v", CellType_MemoryTLymphocyte)
+	}
+	c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: detail})
+	// Deactivate after mitosis.
+	c.MHC_II().ClearPresented()
+	// Keep the original Virgin T Cell.
+	return true
+}
+
+// Memory T Cells split into several Helper and Killer T Cells at once, so the
+// second response to an antigen outpaces the first.
+func MemoryTCellMitosis(ctx context.Context, c MitoticCell) bool {
+	for i := 0; i < MEMORY_CELL_CLONES; i++ {
+		Spawn(c, CellType_HelperTLymphocyte, c.WantPath(), c.MHC_II().presented)
+		Spawn(c, CellType_KillerTLymphocyte, c.WantPath(), c.MHC_II().presented)
+	}
+	c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: fmt.Sprintf("%v x%v, %v x%v", CellType_HelperTLymphocyte, MEMORY_CELL_CLONES, CellType_KillerTLymphocyte, MEMORY_CELL_CLONES)})
+	c.MHC_II().ClearPresented()
+	// Keep the Memory T Cell.
+	return true
+}
+
+func TCellMitosis(ctx context.Context, c MitoticCell) bool {
+	Spawn(c, c.CellType(), c.WantPath(), c.MHC_II().proteins)
+	c.LogEvent(Event{Type: MITOSIS_EVENT})
+	return true
+}
+
+// Split B cell into the original B cell, an Effector B cell and, the first
+// time, a Memory B Cell. Both make the antibody of the B cell, with the isotype the Helper T
+// Cell signaled and the affinity it has matured to so far.
+func BCellMitosis(ctx context.Context, c MitoticCell) bool {
+	immunoglobulin := c.(AntibodyProducing).Immunoglobulin()
+	var spawned []string
+	if SpawnAntibodyProducer(c, CellType_EffectorBLymphocyte, c.WantPath(), c.MHC_II().presented, immunoglobulin) {
+		spawned = append(spawned, CellType_EffectorBLymphocyte.String())
+	}
+	if c.(RememberingCell).CloneMemory().Remember() &&
+		SpawnAntibodyProducer(c, CellType_MemoryBLymphocyte, [10]string{}, c.MHC_II().presented, immunoglobulin) {
+		spawned = append(spawned, CellType_MemoryBLymphocyte.String())
+	}
+	if len(spawned) > 0 {
+		c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: strings.Join(spawned, ", ")})
+	}
+	// Deactivate after mitosis, ready for the next Helper T Cell.
+	c.MHC_II().ClearPresented()
+	// Keep the original B Cell.
+	return true
+}
+
+// Split Memory B Cell into several Effector B cells at once, which make its
+// matured antibody.
+func MemoryBCellMitosis(ctx context.Context, c MitoticCell) bool {
+	immunoglobulin := c.(AntibodyProducing).Immunoglobulin()
+	spawned := 0
+	for i := 0; i < MEMORY_CELL_CLONES; i++ {
+		if SpawnAntibodyProducer(c, CellType_EffectorBLymphocyte, c.WantPath(), c.MHC_II().presented, immunoglobulin) {
+			spawned++
+		}
+	}
+	if spawned > 0 {
+		c.LogEvent(Event{Type: DIFFERENTIATION_EVENT, Detail: fmt.Sprintf("%v x%v", CellType_EffectorBLymphocyte, spawned)})
+	}
+	c.MHC_II().ClearPresented()
+	// Keep the Memory B Cell.
+	return true
+}
+
+func ProduceMetabolicWaste(c *Cell) {
+	c.organ.materialPool.PutWaste(&WasteBlob{
+		creatinine: CREATININE_PRODUCTION,
+		co2:        CELLULAR_RESPIRATION_CO2,
+	})
+}
+
+// Liver cells work on the liver's own pools, blood diffusion carries the rest.
+func HepatocyteWork(ctx context.Context, c *Cell) {
+	switch c.workType {
+	case WorkType_glycogenesis:
+		resource := c.organ.materialPool.GetResource(ctx)
+		if resource.glucose >= GLYCOGEN_UNIT {
+			resource.glucose -= GLYCOGEN_UNIT
+			resource.glycogen += GLYCOGEN_UNIT
+		}
+		c.organ.materialPool.PutResource(resource)
+	case WorkType_glycogenolysis:
+		resource := c.organ.materialPool.GetResource(ctx)
+		if resource.glycogen >= GLYCOGEN_UNIT {
+			resource.glycogen -= GLYCOGEN_UNIT
+			resource.glucose += GLYCOGEN_UNIT
+		}
+		c.organ.materialPool.PutResource(resource)
+	case WorkType_detoxify:
+		waste := c.organ.materialPool.GetWaste(ctx)
+		if waste.ammonia <= AMMONIA_DETOXIFY {
+			waste.ammonia = 0
+		} else {
+			waste.ammonia -= AMMONIA_DETOXIFY
+		}
+		c.organ.materialPool.PutWaste(waste)
+	case WorkType_complement:
+		c.organ.materialPool.PutHormone(&HormoneBlob{
+			complement: COMPLEMENT_PRODUCTION,
+		})
+	}
+}
+
+var respiration = ResourceBlob{
+	o2:      CELLULAR_RESPIRATION_O2,
+	glucose: CELLULAR_RESPIRATION_GLUCOSE,
+}
+
+func init() {
+	eukaryotes := []*CellTypeSpec{
+		{
+			CellType:   CellType_RedBlood,
+			Infectable: true,
+			DoesWork:   true,
+			ResourceNeed: ResourceBlob{
+				o2:      CELLULAR_TRANSPORT_O2,
+				glucose: CELLULAR_TRANSPORT_GLUCOSE,
+			},
+			Aerobic: true,
+			Work: func(ctx context.Context, c *Cell) {
+				c.organ.materialPool.PutWaste(&WasteBlob{
+					co2: CELLULAR_TRANSPORT_CO2,
+				})
+			},
+			LifeSpan:      RED_BLOOD_LIFE_SPAN,
+			WantEdgeTypes: []EdgeType{splenic},
+		},
+		{
+			CellType:     CellType_Neuron,
+			Infectable:   true,
+			DoesWork:     true,
+			ResourceNeed: respiration,
+			Aerobic:      true,
+			ProduceWaste: ProduceMetabolicWaste,
+		},
+		{
+			CellType:     CellType_Cardiomyocyte,
+			Infectable:   true,
+			DoesWork:     true,
+			ResourceNeed: respiration,
+			Aerobic:      true,
+			Work: func(ctx context.Context, c *Cell) {
+				request := c.organ.RequestWork(ctx, Work{
+					workType: WorkType_exhale,
+				})
+				if request.status == 200 {
+					c.organ.materialPool.PutResource(&ResourceBlob{
+						o2: CELLULAR_TRANSPORT_O2,
+					})
+				}
+			},
+			ProduceWaste: ProduceMetabolicWaste,
+		},
+		{
+			CellType:   CellType_Pneumocyte,
+			Infectable: true,
+			DoesWork:   true,
+			Work: func(ctx context.Context, c *Cell) {
+				waste := c.organ.materialPool.GetWaste(ctx)
+				if waste.co2 <= CELLULAR_TRANSPORT_CO2 {
+					waste.co2 = 0
+				} else {
+					waste.co2 -= CELLULAR_TRANSPORT_CO2
+				}
+				c.organ.materialPool.PutWaste(waste)
+				c.organ.materialPool.PutResource(&ResourceBlob{
+					o2: LUNG_O2_INTAKE,
+				})
+			},
+		},
+		{
+			CellType:         CellType_Myocyte,
+			Infectable:       true,
+			DoesWork:         true,
+			ResourceNeed:     respiration,
+			Aerobic:          true,
+			InsulinDependent: true,
+			ProduceWaste:     ProduceMetabolicWaste,
+		},
+		{
+			CellType:   CellType_Keratinocyte,
+			Infectable: true,
+			DoesWork:   true,
+		},
+		{
+			CellType:   CellType_Enterocyte,
+			Infectable: true,
+			DoesWork:   true,
+			Aerobic:    true,
+			Work: func(ctx context.Context, c *Cell) {
+				c.organ.materialPool.PutResource(&ResourceBlob{
+					glucose:  GLUCOSE_INTAKE,
+					vitamins: VITAMIN_INTAKE,
+				})
+			},
+		},
+		{
+			CellType:   CellType_Podocyte,
+			Infectable: true,
+			DoesWork:   true,
+			Work: func(ctx context.Context, c *Cell) {
+				waste := c.organ.materialPool.GetWaste(ctx)
+				if waste.creatinine > CREATININE_GROWTH_THRESHOLD {
+					c.organ.materialPool.PutLigand(&LigandBlob{
+						growth: CREATININE_LIGAND_GROWTH,
+					})
+				}
+				if waste.creatinine <= CREATININE_FILTRATE {
+					waste.creatinine = 0
+				} else {
+					waste.creatinine -= CREATININE_FILTRATE
+				}
+				c.organ.materialPool.PutWaste(waste)
+			},
+		},
+		{
+			CellType:     CellType_Hepatocyte,
+			Infectable:   true,
+			DoesWork:     true,
+			ResourceNeed: respiration,
+			Aerobic:      true,
+			Work:         HepatocyteWork,
+			ProduceWaste: ProduceMetabolicWaste,
+		},
+		{
+			CellType:   CellType_ThymicEpithelial,
+			Infectable: true,
+			Aerobic:    true,
+		},
+		{
+			CellType:   CellType_PancreaticBeta,
+			Infectable: true,
+			Aerobic:    true,
+		},
+		{
+			CellType:   CellType_PancreaticAlpha,
+			Infectable: true,
+			Aerobic:    true,
+		},
+		{
+			CellType:    CellType_Hemocytoblast,
+			Infectable:  true,
+			Aerobic:     true,
+			WillMitosis: HemocytoblastWillMitosis,
+		},
+	}
+	for _, spec := range eukaryotes {
+		spec.DNAType = HUMAN_DNA
+		spec.Make = MakeEukaryoticCell
+		spec.SpawnDisplacement = SPAWN_DISPLACEMENT
+		if spec.WillMitosis == nil {
+			spec.WillMitosis = WillMitosisOnGrowth
+		}
+		RegisterCellType(spec)
+	}
+
+	makeStemCell := func(cell *Cell, mhc_ii *MHC_II) CellActor {
+		return &LeukocyteStemCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+	}
+	leukocytes := []*CellTypeSpec{
+		{
+			CellType:      CellType_Lymphoblast,
+			Infectable:    true,
+			Make:          makeStemCell,
+			LifeSpan:      LEUKOCYTE_STEM_CELL_LIFE_SPAN,
+			TransportSpan: LEUKOCYTE_STEM_CELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			WantEdgeTypes: []EdgeType{thymic},
+			WillMitosis: func(ctx context.Context, c MitoticCell) bool {
+				return InThymus(c.Organ()) || WillDifferentiateOnInflammation(ctx, c)
+			},
+			Mitosis: LymphoblastMitosis,
+		},
+		{
+			CellType:      CellType_Myeloblast,
+			Infectable:    true,
+			Make:          makeStemCell,
+			LifeSpan:      LEUKOCYTE_STEM_CELL_LIFE_SPAN,
+			TransportSpan: LEUKOCYTE_STEM_CELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			WillMitosis:   WillDifferentiateOnInflammation,
+			// Can differentiate into Neutrophil.
+			Mitosis: func(ctx context.Context, c MitoticCell) bool {
+				return Differentiate(c, CellType_Neutrocyte)
+			},
+		},
+		{
+			CellType:      CellType_Monocyte,
+			Infectable:    true,
+			Make:          makeStemCell,
+			LifeSpan:      LEUKOCYTE_STEM_CELL_LIFE_SPAN,
+			TransportSpan: LEUKOCYTE_STEM_CELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			WillMitosis:   WillDifferentiateOnInflammation,
+			Mitosis:       MonocyteMitosis,
+		},
+		{
+			CellType:   CellType_Macrophagocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &Macrophage{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      MACROPHAGE_LIFE_SPAN,
+			TransportSpan: MACROPHAGE_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanInteract:   true,
+		},
+		{
+			CellType:   CellType_Dendritic,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &DendriticCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      DENDRITIC_CELL_LIFE_SPAN,
+			TransportSpan: DENDRITIC_CELL_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanTransport:  true,
+			CanInteract:   true,
+		},
+		{
+			CellType:   CellType_Neutrocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &Neutrophil{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      NEUTROPHIL_LIFE_SPAN,
+			TransportSpan: NEUTROPHIL_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanTransport:  true,
+			CanInteract:   true,
+		},
+		{
+			CellType:   CellType_NaturalKillerCell,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &NaturalKiller{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      NATURALKILLER_LIFE_SPAN,
+			TransportSpan: NATURALKILLER_TRANSPORT_SPAN,
+			CanTransport:  true,
+			CanInteract:   true,
+		},
+		{
+			CellType:   CellType_Thymocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &Thymocyte{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:    THYMOCYTE_LIFE_SPAN,
+			CanInteract: true,
+			// Matures once Thymocyte.WillMitosis says it survived selection.
+			Mitosis: func(ctx context.Context, c MitoticCell) bool {
+				return Differentiate(c, CellType_VirginTLymphocyte)
+			},
+		},
+		{
+			CellType:   CellType_VirginTLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &VirginTCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      VIRGIN_TCELL_LIFE_SPAN,
+			TransportSpan: VIRGIN_TCELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			WantEdgeTypes: []EdgeType{lymphatic},
+			WillMitosis:   WillDifferentiateWhenPresented,
+			Mitosis:       VirginTCellMitosis,
+		},
+		{
+			CellType:   CellType_HelperTLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &HelperTCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      HELPER_TCELL_LIFE_SPAN,
+			TransportSpan: HELPER_TCELL_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanTransport:  true,
+			CanInteract:   true,
+			// B cells wait in the bone marrow and the spleen.
+			WantEdgeTypes: []EdgeType{skeletal, splenic},
+			WillMitosis:   WillMitosisOnInterleukin2,
+			Mitosis:       TCellMitosis,
+		},
+		{
+			CellType:   CellType_KillerTLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &KillerTCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      KILLER_TCELL_LIFE_SPAN,
+			TransportSpan: KILLER_TCELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			CanInteract:   true,
+			WillMitosis:   WillMitosisOnInterleukin2,
+			Mitosis:       TCellMitosis,
+		},
+		{
+			CellType:   CellType_RegulatoryTLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &RegulatoryTCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}
+			},
+			LifeSpan:      REGULATORY_TCELL_LIFE_SPAN,
+			TransportSpan: REGULATORY_TCELL_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanTransport:  true,
+		},
+		{
+			CellType:   CellType_BLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &BCell{Leukocyte: MakeLeukocyte(cell, mhc_ii), immunoglobulin: MakeImmunoglobulin()}
+			},
+			LifeSpan:      BCELL_LIFE_SPAN,
+			TransportSpan: BCELL_TRANSPORT_SPAN,
+			WillMitosis:   WillDifferentiateWhenPresented,
+			Mitosis:       BCellMitosis,
+		},
+		{
+			CellType:   CellType_MemoryTLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &MemoryTCell{MemoryCell: &MemoryCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}}
+			},
+			LifeSpan:      MEMORY_TCELL_LIFE_SPAN,
+			TransportSpan: MEMORY_TCELL_TRANSPORT_SPAN,
+			CanTransport:  true,
+			CanInteract:   true,
+			WantEdgeTypes: []EdgeType{lymphatic},
+			WillMitosis:   WillDifferentiateWhenPresented,
+			Mitosis:       MemoryTCellMitosis,
+		},
+		{
+			CellType:   CellType_MemoryBLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &MemoryBCell{MemoryCell: &MemoryCell{Leukocyte: MakeLeukocyte(cell, mhc_ii)}, immunoglobulin: MakeImmunoglobulin()}
+			},
+			LifeSpan:      MEMORY_BCELL_LIFE_SPAN,
+			TransportSpan: MEMORY_BCELL_TRANSPORT_SPAN,
+			DoesWork:      true,
+			CanTransport:  true,
+			WantEdgeTypes: []EdgeType{lymphatic},
+			WillMitosis:   WillDifferentiateWhenPresented,
+			Mitosis:       MemoryBCellMitosis,
+		},
+		{
+			CellType:   CellType_EffectorBLymphocyte,
+			Infectable: true,
+			Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+				return &EffectorBCell{Leukocyte: MakeLeukocyte(cell, mhc_ii), immunoglobulin: MakeImmunoglobulin()}
+			},
+			LifeSpan:      EFFECTOR_BCELL_LIFE_SPAN,
+			TransportSpan: EFFECTOR_BCELL_TRANSPORT_SPAN,
+			DoesWork:      true,
+		},
+	}
+	for _, spec := range leukocytes {
+		spec.DNAType = HUMAN_DNA
+		RegisterCellType(spec)
+	}
+
+	makeProkaryote := func(cell *Cell, mhc_ii *MHC_II) CellActor {
+		return &ProkaryoticCell{Cell: cell}
+	}
+	RegisterCellType(&CellTypeSpec{
+		CellType:       CellType_Bacteria,
+		DNAType:        BACTERIA_DNA,
+		Make:           makeProkaryote,
+		ResourceNeed:   respiration,
+		TransportSpan:  DEFAULT_BACTERIA_TRANSPORT_DURATION,
+		GenerationTime: DEFAULT_BACTERIA_GENERATION_DURATION,
+		ProduceWaste: func(c *Cell) {
+			c.DropCytokine(CytokineType_cytotoxins, CYTOKINE_CYTOTOXINS)
+			ProduceMetabolicWaste(c)
+			c.organ.materialPool.PutWaste(&WasteBlob{
+				ammonia: BACTERIA_AMMONIA_PRODUCTION,
+			})
+		},
+		CanTransport:  true,
+		WantEdgeTypes: []EdgeType{muscular},
+	})
+	RegisterCellType(&CellTypeSpec{
+		CellType:       CellType_Bacteroidota,
+		DNAType:        BACTERIA_DNA,
+		Make:           makeProkaryote,
+		ResourceNeed:   respiration,
+		Aerobic:        true,
+		TransportSpan:  GUT_BACTERIA_TRANSPORT_DURATION,
+		GenerationTime: GUT_BACTERIA_GENERATION_DURATION,
+		ProduceWaste: func(c *Cell) {
+			c.organ.materialPool.PutWaste(&WasteBlob{
+				co2:     CELLULAR_RESPIRATION_CO2,
+				ammonia: BACTERIA_AMMONIA_PRODUCTION,
+			})
+			c.organ.materialPool.PutResource(&ResourceBlob{
+				vitamins: BACTERIA_VITAMIN_PRODUCTION,
+			})
+		},
+		CanTransport:  true,
+		WantEdgeTypes: []EdgeType{gut_lining},
+	})
+
+	RegisterCellType(&CellTypeSpec{
+		CellType: CellType_ViralLoadCarrier,
+		DNAType:  VIRUS_RNA,
+		Make: func(cell *Cell, mhc_ii *MHC_II) CellActor {
+			// Never rendered, it only carries its virus to the node.
+			cell.render = &Renderable{}
+			carrier := &VirusCarrier{Cell: cell}
+			carrier.virus = &Virus{
+				dna:            cell.dna,
+				targetCellType: carrier.GetTargetCellType(cell.dna),
+				infectivity:    carrier.GetInfectivity(cell.dna),
+			}
+			return carrier
+		},
+	})
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCellTypeRegistry(t *testing.T) {
+	dnas := map[DNAType]*DNA{
+		HUMAN_DNA:    MakeDNA(HUMAN_DNA, HUMAN_NAME),
+		BACTERIA_DNA: MakeDNA(BACTERIA_DNA, "Registry Bacteria"),
+		VIRUS_RNA:    MakeDNA(VIRUS_RNA, "Registry Virus"),
+	}
+	for value := range CellType_name {
+		cellType := CellType(value)
+		if cellType == CellType_CellTypeUnknown {
+			if _, err := ParseCellType(cellType.String()); err == nil {
+				t.Error("Expected the unknown cell type not to parse")
+			}
+			continue
+		}
+		spec := LookupCellType(cellType)
+		if spec.Make == nil || dnas[spec.DNAType] == nil {
+			t.Errorf("Expected %v to be registered", cellType)
+			continue
+		}
+		if _, err := ParseCellType(cellType.String()); err != nil {
+			t.Error(err)
+		}
+		cell := MakeCellFromType(cellType, WorkType_nothing, dnas[spec.DNAType], &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+		if cell.CellType() != cellType || cell.DNA() != dnas[spec.DNAType] {
+			t.Errorf("Expected a %v, got: %v", cellType, cell)
+		}
+		if cell.CanTransport() != spec.CanTransport || cell.IsAerobic() != spec.Aerobic {
+			t.Errorf("Expected %v to take after its spec", cellType)
+		}
+	}
+}
+
+func TestRegisterCellType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected registering a cell type twice to panic")
+		}
+	}()
+	RegisterCellType(&CellTypeSpec{CellType: CellType_Neuron})
+}
+
+func TestHepatocyteWork(t *testing.T) {
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: InitializeMemoryTransport(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node := InitializeNewNode(ctx, testGraph, "Liver", false)
+	node.materialPool.Seed(&MaterialSeed{O2: 1000, Glucose: 1000, Ammonia: 15})
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	for _, workType := range []WorkType{WorkType_glycogenesis, WorkType_detoxify, WorkType_complement} {
+		cell := MakeCellFromType(CellType_Hepatocyte, workType, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+		cell.SetOrgan(node)
+		if result := cell.(*EukaryoticCell).Work(ctx, Work{workType: workType}); result.status != 200 {
+			t.Fatalf("Expected %v to complete, got: %v", workType, result)
+		}
+	}
+	status := node.GetMaterialStatus()
+	if status.Glycogen != GLYCOGEN_UNIT || status.Ammonia >= 15 || status.Complement != COMPLEMENT_PRODUCTION {
+		t.Errorf("Expected the liver to store glucose, detoxify and secrete complement, got: %v", status)
+	}
+	if diffused := node.materialPool.SplitResource(ctx); diffused.glycogen != 0 || node.GetMaterialStatus().Glycogen != GLYCOGEN_UNIT {
+		t.Errorf("Expected glycogen to stay in the liver, diffused: %v", diffused)
+	}
+	cell := MakeCellFromType(CellType_Hepatocyte, WorkType_glycogenolysis, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	cell.SetOrgan(node)
+	cell.(*EukaryoticCell).Work(ctx, Work{workType: WorkType_glycogenolysis})
+	if status := node.GetMaterialStatus(); status.Glycogen != 0 {
+		t.Errorf("Expected the liver to release its glycogen, got: %v", status.Glycogen)
+	}
+}
+
+func TestPancreas(t *testing.T) {
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: InitializeMemoryTransport(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	pancreas := InitializeNewNode(ctx, testGraph, "Pancreas", false)
+	pancreas.materialPool.Seed(&MaterialSeed{Glucose: 500})
+	beta := MakeCellFromType(CellType_PancreaticBeta, WorkType_nothing, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	beta.SetOrgan(pancreas)
+	alpha := MakeCellFromType(CellType_PancreaticAlpha, WorkType_nothing, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	alpha.SetOrgan(pancreas)
+	SecreteInsulin(ctx, beta)
+	SecreteGlucagon(ctx, alpha)
+	if status := pancreas.GetMaterialStatus(); status.Insulin <= 0 || status.Glucagon != GLUCAGON_SECRETION {
+		t.Errorf("Expected low glucose to draw insulin and glucagon, got: %v", status)
+	}
+
+	muscle := InitializeNewNode(ctx, testGraph, "Muscle", false)
+	muscle.materialPool.Seed(&MaterialSeed{O2: 1000, Glucose: 1000})
+	myocyte := MakeCellFromType(CellType_Myocyte, WorkType_move, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand).(*EukaryoticCell)
+	myocyte.SetOrgan(muscle)
+	if result := myocyte.Work(ctx, Work{workType: WorkType_move}); result.status == 200 {
+		t.Error("Expected a muscle cell not to take up glucose without insulin")
+	}
+	muscle.materialPool.PutHormone(&HormoneBlob{insulin: 2 * INSULIN_UPTAKE})
+	if result := myocyte.Work(ctx, Work{workType: WorkType_move}); result.status != 200 {
+		t.Errorf("Expected a muscle cell to take up glucose with insulin, got: %v", result)
+	}
+	if status := muscle.GetMaterialStatus(); status.Insulin >= 2*INSULIN_UPTAKE {
+		t.Errorf("Expected the insulin to be used up, got: %v", status.Insulin)
+	}
+}
+
+func TestMemoryCells(t *testing.T) {
+	now := time.Unix(100000, 0)
+	body := restoreTestBody(t, now, []*NodeSnapshot{{Name: "Memory Lymph Node", Organ: "lymph"}})
+	node := body.FindNode("Memory Lymph Node")
+	antigen := body.human.selfProteins[0] + 1
+	rested := now.Add(-2 * MEMORY_CELL_REST).UnixNano()
+
+	memoryT := body.restore(node, body.human, &CellSnapshot{CellType: CellType_MemoryTLymphocyte, SpawnTime: rested}, antigen).(*MemoryTCell)
+	memoryT.Interact(context.Background(), body.restore(node, body.human, &CellSnapshot{CellType: CellType_Dendritic}, antigen+1))
+	if memoryT.WillMitosis(context.Background()) {
+		t.Error("Expected a Memory T Cell to ignore other antigens")
+	}
+	// A dendritic cell that already activated a T cell still reactivates it.
+	dendritic := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Dendritic}, antigen)
+	dendritic.MHC_II().SetPresented([]Protein{antigen})
+	memoryT.Interact(context.Background(), dendritic)
+	if !memoryT.WillMitosis(context.Background()) {
+		t.Error("Expected a Memory T Cell to reactivate on its antigen")
+	}
+	memoryT.MHC_II().ClearPresented()
+	memoryT.Interact(context.Background(), dendritic)
+	if memoryT.WillMitosis(context.Background()) {
+		t.Error("Expected a reactivated Memory T Cell to rest")
+	}
+	fresh := body.restore(node, body.human, &CellSnapshot{CellType: CellType_MemoryTLymphocyte}, antigen).(*MemoryTCell)
+	fresh.Interact(context.Background(), dendritic)
+	if fresh.WillMitosis(context.Background()) {
+		t.Error("Expected a new Memory T Cell to rest")
+	}
+
+	memoryB := body.restore(node, body.human, &CellSnapshot{CellType: CellType_MemoryBLymphocyte, SpawnTime: rested}, antigen).(*MemoryBCell)
+	memoryB.ShouldActivate([]Protein{antigen}, "")
+	if !memoryB.WillMitosis(context.Background()) {
+		t.Error("Expected a Memory B Cell to reactivate on its antigen alone")
+	}
+	if snapshot := memoryB.Snapshot(body.dnas); snapshot.ActivationTime != now.UnixNano() {
+		t.Errorf("Expected the Memory B Cell to remember when it was activated, got: %v", snapshot.ActivationTime)
+	}
+
+	// However often a clone is activated, it leaves so many memory cells.
+	countMemoryT := func() (count int) {
+		for _, cell := range node.Cells() {
+			if cell.CellType() == CellType_MemoryTLymphocyte {
+				count++
+			}
+		}
+		return
+	}
+	before := countMemoryT()
+	virginT := body.restore(node, body.human, &CellSnapshot{CellType: CellType_VirginTLymphocyte}, antigen).(*VirginTCell)
+	for i := 0; i < MEMORY_CELLS_PER_CLONE+2; i++ {
+		virginT.MHC_II().SetPresented([]Protein{antigen})
+		virginT.Mitosis(context.Background())
+	}
+	if made := countMemoryT() - before; made != MEMORY_CELLS_PER_CLONE {
+		t.Errorf("Expected the clone to leave %v Memory T Cells, got: %v", MEMORY_CELLS_PER_CLONE, made)
+	}
+	snapshot := virginT.Snapshot(body.dnas)
+	if snapshot.MemoryCells != MEMORY_CELLS_PER_CLONE {
+		t.Errorf("Expected the clone to remember its memory cells, got: %v", snapshot.MemoryCells)
+	}
+	restored := body.restore(node, body.human, snapshot).(*VirginTCell)
+	if restored.CloneMemory().Remember() {
+		t.Error("Expected a restored clone not to leave more memory cells")
+	}
+}
+
+// The second exposure to an antigen makes Effector B Cells sooner than the
+// first, since Memory B Cells don't wait for a Helper T Cell. Cells only sense
+// on their own, while the test gives every cell a turn at each step of the
+// clock, with every other cell within reach.
+func TestSecondResponse(t *testing.T) {
+	steps := `{"action": "Sense"}`
+	programs, err := ParsePrograms([]byte(`{"programs": {
+		"Dendritic": [` + steps + `], "VirginTLymphocyte": [` + steps + `], "BLymphocyte": [` + steps + `],
+		"HelperTLymphocyte": [` + steps + `], "KillerTLymphocyte": [` + steps + `], "RegulatoryTLymphocyte": [` + steps + `],
+		"MemoryTLymphocyte": [` + steps + `], "MemoryBLymphocyte": [` + steps + `], "EffectorBLymphocyte": [` + steps + `]
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	UsePrograms(programs)
+	defer UsePrograms(nil)
+	body := restoreTestBody(t, time.Unix(100000, 0), []*NodeSnapshot{
+		{Name: "Response Lymph Node", Organ: "lymph", Materials: &MaterialStatusSocketData{Vitamin: 100000}},
+	})
+	events := &bytes.Buffer{}
+	body.events.SetWriter(events)
+	node := body.FindNode("Response Lymph Node")
+	vaccine := Exposure{Action: VACCINATE_ACTION, Pathogen: "Response Bacteria", Kind: BACTERIA_PATHOGEN, Node: "Response Lymph Node", Dose: 1}
+	pathogen := body.pathogens.GetDNA(vaccine)
+	body.restore(node, body.human, &CellSnapshot{CellType: CellType_VirginTLymphocyte}, pathogen.selfProteins...)
+	body.restore(node, body.human, &CellSnapshot{CellType: CellType_BLymphocyte}, pathogen.selfProteins...)
+	body.restore(node, body.human, &CellSnapshot{CellType: CellType_Dendritic})
+
+	step := func() {
+		ctx := context.Background()
+		cells := node.Cells()
+		for _, cell := range cells {
+			if LookupCellType(cell.CellType()).DoesWork {
+				cell.DoWork(ctx)
+			}
+		}
+		for _, cell := range cells {
+			for _, other := range cells {
+				if cell != other && cell.CanInteract() {
+					cell.Interact(ctx, other)
+				}
+			}
+		}
+		for _, cell := range cells {
+			WillMitosisAndRepair(ctx, cell)
+		}
+		body.clock.Step(CELL_CLOCK_RATE)
+	}
+	respond := func() {
+		body.Expose(context.Background(), vaccine)
+		for i := 0; i < 10; i++ {
+			step()
+		}
+	}
+	respond()
+	body.clock.Step(MEMORY_CELL_REST)
+	respond()
+
+	var exposures, effectors []time.Time
+	err = ReadEvents(bytes.NewReader(events.Bytes()), EventFilter{}, func(e Event) {
+		switch {
+		case e.Type == VACCINATION_EVENT:
+			exposures = append(exposures, e.Time)
+		case e.Type == DIFFERENTIATION_EVENT && strings.Contains(e.Detail, CellType_EffectorBLymphocyte.String()):
+			if len(effectors) < len(exposures) {
+				effectors = append(effectors, e.Time)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exposures) != 2 || len(effectors) != 2 {
+		t.Fatalf("Expected an Effector B Cell after each of 2 exposures, got %v after %v", len(effectors), len(exposures))
+	}
+	first, second := effectors[0].Sub(exposures[0]), effectors[1].Sub(exposures[1])
+	if second >= first {
+		t.Errorf("Expected the second response to make an Effector B Cell sooner, took %v then %v", first, second)
+	}
+}
+
+func TestUndeliveredAntibodyProducer(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(100000, 0), []*NodeSnapshot{{Name: "Undelivered Lymph Node", Organ: "lymph"}})
+	node := body.FindNode("Undelivered Lymph Node")
+	bCell := body.restore(node, body.human, &CellSnapshot{CellType: CellType_BLymphocyte}, body.human.selfProteins[0]+1).(MitoticCell)
+	differentiations, unsubscribe := body.events.Subscribe(EventFilter{Type: DIFFERENTIATION_EVENT})
+	defer unsubscribe()
+
+	address := node.transportUrl
+	node.transportUrl = "memory://nowhere" + TRANSPORT_ENDPOINT
+	if !BCellMitosis(context.Background(), bCell) {
+		t.Error("Expected the B Cell to survive its daughters going missing")
+	}
+	node.transportUrl = address
+	if cells := node.Cells(); len(cells) != 1 {
+		t.Errorf("Expected no daughters to arrive, got %v cells", len(cells))
+	}
+	select {
+	case e := <-differentiations:
+		t.Errorf("Expected no differentiation without daughters, got: %+v", e)
+	default:
+	}
+
+	BCellMitosis(context.Background(), bCell)
+	select {
+	case e := <-differentiations:
+		if !strings.Contains(e.Detail, CellType_EffectorBLymphocyte.String()) {
+			t.Errorf("Expected an Effector B Cell, got: %+v", e)
+		}
+	default:
+		t.Error("Expected a differentiation once the daughters arrive")
+	}
+}
+
+func TestRegulatoryTCells(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(100000, 0), []*NodeSnapshot{{Name: "Regulatory Lymph Node", Organ: "lymph"}})
+	node := body.FindNode("Regulatory Lymph Node")
+	antigen := body.human.selfProteins[0] + 1
+	here := &Position{}
+	away := &Position{X: 5, Y: 5}
+
+	node.materialPool.PutHormone(&HormoneBlob{interleukin_2: 4 * HORMONE_IL2_THRESHOLD})
+	node.materialPool.PutLigand(&LigandBlob{inflammation: LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD})
+	regulatory := body.restore(node, body.human, &CellSnapshot{CellType: CellType_RegulatoryTLymphocyte, Position: here}, antigen)
+	before := node.GetMaterialStatus()
+	regulatory.DoWork(context.Background())
+	after := node.GetMaterialStatus()
+	if after.Il_2 >= before.Il_2 || after.Il_10 <= before.Il_10 {
+		t.Errorf("Expected a Regulatory T Cell to trade IL-2 for IL-10, went from %v to %v", before, after)
+	}
+	if after.Inflammation >= before.Inflammation {
+		t.Errorf("Expected a Regulatory T Cell to reduce inflammation, went from %v to %v", before.Inflammation, after.Inflammation)
+	}
+
+	helper := body.restore(node, body.human, &CellSnapshot{CellType: CellType_HelperTLymphocyte, Position: away}, antigen)
+	if !helper.WillMitosis(context.Background()) {
+		t.Error("Expected a Helper T Cell to divide on IL-2")
+	}
+	setHormones := func(hormones HormoneBlob) {
+		node.materialPool.hormonePool.Lock()
+		*node.materialPool.hormonePool.hormones = hormones
+		node.materialPool.hormonePool.Unlock()
+	}
+	// IL-10 holds back a division on IL-2, and is used up doing so.
+	setHormones(HormoneBlob{interleukin_2: 4 * HORMONE_IL2_THRESHOLD, interleukin_10: 4 * HORMONE_IL10_THRESHOLD})
+	before = node.GetMaterialStatus()
+	if helper.WillMitosis(context.Background()) {
+		t.Error("Expected IL-10 to brake Helper T Cell mitosis")
+	}
+	after = node.GetMaterialStatus()
+	if after.Il_10 > before.Il_10 || after.Il_2 <= before.Il_2-HORMONE_IL2_THRESHOLD {
+		t.Errorf("Expected the brake to bind IL-10 and leave the IL-2, went from %v to %v", before, after)
+	}
+	// Without the IL-2 to divide on, there is nothing to brake.
+	setHormones(HormoneBlob{interleukin_10: 4 * HORMONE_IL10_THRESHOLD})
+	for i := 0; i < 10; i++ {
+		helper.WillMitosis(context.Background())
+	}
+	if status := node.GetMaterialStatus(); status.Il_10 < 4*HORMONE_IL10_THRESHOLD-1 {
+		t.Errorf("Expected IL-10 to last while there is no IL-2, got: %v", status.Il_10)
+	}
+	// While the IL-2 stays high, a Regulatory T Cell keeps up the brake.
+	setHormones(HormoneBlob{})
+	divisions := 0
+	for i := 0; i < 100; i++ {
+		node.materialPool.PutHormone(&HormoneBlob{interleukin_2: HORMONE_IL2_THRESHOLD})
+		regulatory.DoWork(context.Background())
+		if helper.WillMitosis(context.Background()) {
+			divisions++
+		}
+	}
+	if status := node.GetMaterialStatus(); divisions > 25 || status.Il_2 < 4*HORMONE_IL2_THRESHOLD {
+		t.Errorf("Expected Regulatory T Cells to hold back most divisions on plentiful IL-2, got %v divisions, %v", divisions, status)
+	}
+
+	// The anti-inflammatory cytokine keeps cells close by from activating.
+	nearby := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Macrophagocyte, Position: here}).(*Macrophage)
+	nearby.ShouldActivate(Event{Type: ACTIVATION_EVENT})
+	if nearby.IsActivated() {
+		t.Error("Expected a Macrophage next to a Regulatory T Cell not to activate")
+	}
+	distant := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Macrophagocyte, Position: away}).(*Macrophage)
+	distant.ShouldActivate(Event{Type: ACTIVATION_EVENT})
+	if !distant.IsActivated() {
+		t.Error("Expected a Macrophage away from Regulatory T Cells to activate")
+	}
+	virginT := body.restore(node, body.human, &CellSnapshot{CellType: CellType_VirginTLymphocyte, Position: here}, antigen).(*VirginTCell)
+	virginT.ShouldActivate(body.restore(node, body.human, &CellSnapshot{CellType: CellType_Dendritic, Position: here}, antigen).(*DendriticCell))
+	if len(virginT.MHC_II().GetPresented()) > 0 {
+		t.Error("Expected a Virgin T Cell next to a Regulatory T Cell not to activate")
+	}
+}
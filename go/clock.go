@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Clock is the simulation's virtual time. It runs at a multiple of wall time
+// and can be paused, then stepped forward by hand.
+type Clock struct {
+	sync.RWMutex
+	now     time.Time // Virtual time as of anchor.
+	anchor  time.Time // Wall time when now was recorded.
+	speed   float64
+	paused  bool
+	held    int // Holds stop the clock without pausing it.
+	changed chan struct{}
+}
+
+// For anything that runs without a body, like cells that were never placed.
+var defaultClock = InitializeClock()
+
+func InitializeClock() *Clock {
+	now := time.Now()
+	return &Clock{
+		now:     now,
+		anchor:  now,
+		speed:   1,
+		changed: make(chan struct{}),
+	}
+}
+
+func (c *Clock) stopped() bool {
+	return c.paused || c.held > 0
+}
+
+func (c *Clock) current() time.Time {
+	if c.stopped() {
+		return c.now
+	}
+	return c.now.Add(time.Duration(float64(time.Since(c.anchor)) * c.speed))
+}
+
+func (c *Clock) Now() time.Time {
+	c.RLock()
+	defer c.RUnlock()
+	return c.current()
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *Clock) Until(t time.Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+// Records the current time, applies the change and wakes up anyone waiting.
+func (c *Clock) update(change func()) {
+	c.Lock()
+	defer c.Unlock()
+	c.now = c.current()
+	c.anchor = time.Now()
+	change()
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *Clock) Pause() {
+	c.update(func() {
+		c.paused = true
+	})
+}
+
+func (c *Clock) Resume() {
+	c.update(func() {
+		c.paused = false
+	})
+}
+
+// Hold stops the clock until release is called, like a pause that leaves any
+// pause or resume in the meantime to take effect once released.
+func (c *Clock) Hold() (release func()) {
+	c.update(func() {
+		c.held++
+	})
+	once := &sync.Once{}
+	return func() {
+		once.Do(func() {
+			c.update(func() {
+				c.held--
+			})
+		})
+	}
+}
+
+func (c *Clock) SetSpeed(speed float64) error {
+	if speed <= 0 {
+		return fmt.Errorf("clock speed must be positive, got %v", speed)
+	}
+	c.update(func() {
+		c.speed = speed
+	})
+	return nil
+}
+
+// Set moves the clock to the given time, e.g. when restoring a snapshot.
+func (c *Clock) Set(now time.Time) {
+	c.update(func() {
+		c.now = now
+	})
+}
+
+// Sync matches the clock to another's status, like the clock of the process
+// an admin request reached.
+func (c *Clock) Sync(status ClockStatus) error {
+	if status.Speed <= 0 {
+		return fmt.Errorf("clock speed must be positive, got %v", status.Speed)
+	}
+	c.update(func() {
+		c.now = status.Now
+		c.paused = status.Paused
+		c.speed = status.Speed
+	})
+	return nil
+}
+
+func (c *Clock) Step(d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("clock step must be positive, got %v", d)
+	}
+	c.RLock()
+	paused := c.paused
+	c.RUnlock()
+	if !paused {
+		return fmt.Errorf("clock must be paused to step")
+	}
+	c.update(func() {
+		c.now = c.now.Add(d)
+	})
+	return nil
+}
+
+// WaitUntil blocks until the clock reaches t. Returns false if the context
+// finished first.
+func (c *Clock) WaitUntil(ctx context.Context, t time.Time) bool {
+	for {
+		c.RLock()
+		now := c.current()
+		paused := c.stopped()
+		speed := c.speed
+		changed := c.changed
+		c.RUnlock()
+		if !now.Before(t) {
+			return true
+		}
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !paused {
+			timer = time.NewTimer(time.Duration(float64(t.Sub(now)) / speed))
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+	}
+}
+
+// Ticker mirrors time.Ticker on the virtual clock: ticks are dropped rather
+// than queued when the receiver falls behind.
+type Ticker struct {
+	C    <-chan time.Time
+	stop context.CancelFunc
+}
+
+func (c *Clock) NewTicker(ctx context.Context, d time.Duration) *Ticker {
+	ctx, stop := context.WithCancel(ctx)
+	ticks := make(chan time.Time, 1)
+	go func() {
+		next := c.Now().Add(d)
+		for c.WaitUntil(ctx, next) {
+			select {
+			case ticks <- next:
+			default:
+			}
+			next = next.Add(d)
+			if now := c.Now(); next.Before(now) {
+				next = now.Add(d)
+			}
+		}
+	}()
+	return &Ticker{
+		C:    ticks,
+		stop: stop,
+	}
+}
+
+func (t *Ticker) Stop() {
+	t.stop()
+}
+
+type ClockRequest struct {
+	Action   string   `json:"action"` // One of pause, resume, step or speed.
+	Speed    float64  `json:"speed"`
+	Duration Duration `json:"duration"` // Step size, defaults to a single cell tick.
+}
+
+type ClockStatus struct {
+	Now    time.Time `json:"now"`
+	Paused bool      `json:"paused"`
+	Speed  float64   `json:"speed"`
+}
+
+func (c *Clock) Status() ClockStatus {
+	c.RLock()
+	defer c.RUnlock()
+	return ClockStatus{
+		Now:    c.current(),
+		Paused: c.paused,
+		Speed:  c.speed,
+	}
+}
+
+// HandleClockRequest controls the clock of the whole body, and carries any
+// change on to the `efflux node` processes hosting the rest of it.
+func (b *Body) HandleClockRequest(w http.ResponseWriter, r *http.Request) {
+	c := b.clock
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		request := &ClockRequest{}
+		err = json.Unmarshal(data, request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch request.Action {
+		case "pause":
+			c.Pause()
+		case "resume":
+			c.Resume()
+		case "step":
+			if request.Duration == 0 {
+				request.Duration = Duration(CELL_CLOCK_RATE)
+			}
+			err = c.Step(time.Duration(request.Duration))
+		case "speed":
+			err = c.SetSpeed(request.Speed)
+		default:
+			err = fmt.Errorf("unknown clock action: %q", request.Action)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = b.ForwardClock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	default:
+		http.Error(w, "Clock requests must be GET or POST.", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(c.Status())
+}
+
+// ForwardClock sets the clocks of the processes hosting remote nodes to this
+// one's. Every node of a process shares its clock, so setting it once per node
+// is harmless.
+func (b *Body) ForwardClock() error {
+	status := b.clock.Status()
+	for name := range b.remote {
+		err := b.forward(name, &ControlRequest{Clock: &status})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestClockPauseAndStep(t *testing.T) {
+	clock := InitializeClock()
+	if err := clock.Step(time.Second); err == nil {
+		t.Errorf("expected stepping a running clock to fail")
+	}
+	clock.Pause()
+	paused := clock.Now()
+	time.Sleep(10 * time.Millisecond)
+	if !clock.Now().Equal(paused) {
+		t.Errorf("expected a paused clock to stand still")
+	}
+	if err := clock.Step(time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if got := clock.Since(paused); got != time.Hour {
+		t.Errorf("clock.Since() = %v, want %v", got, time.Hour)
+	}
+	if err := clock.SetSpeed(0); err == nil {
+		t.Errorf("expected a speed of 0 to fail")
+	}
+}
+
+func TestClockTickerSteps(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := InitializeClock()
+	clock.Pause()
+	ticker := clock.NewTicker(ctx, time.Minute)
+	defer ticker.Stop()
+	select {
+	case <-ticker.C:
+		t.Fatalf("expected no ticks while paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+	clock.Step(time.Minute)
+	select {
+	case <-ticker.C:
+	case <-time.After(time.Second):
+		t.Fatalf("expected a tick after stepping")
+	}
+}
+
+func TestClockSpeed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	clock := InitializeClock()
+	clock.SetSpeed(1000)
+	if !clock.WaitUntil(ctx, clock.Now().Add(time.Minute)) {
+		t.Errorf("expected a minute to pass in under a second at 1000x")
+	}
+}
+
+func TestClockHold(t *testing.T) {
+	clock := InitializeClock()
+	release := clock.Hold()
+	held := clock.Now()
+	time.Sleep(10 * time.Millisecond)
+	if !clock.Now().Equal(held) || clock.Status().Paused {
+		t.Errorf("expected a held clock to stand still without pausing")
+	}
+	clock.Pause()
+	release()
+	release()
+	if !clock.Status().Paused || !clock.Now().Equal(held) {
+		t.Errorf("expected a pause while held to outlast the hold")
+	}
+	clock.Resume()
+	time.Sleep(10 * time.Millisecond)
+	if !clock.Now().After(held) {
+		t.Errorf("expected a released clock to run once resumed")
+	}
+}
@@ -8,17 +8,48 @@ import (
 const WORK_ENDPOINT = "/work"
 const STATUS_ENDPOINT = "/status"
 const TRANSPORT_ENDPOINT = "/transport"
+const CONTROL_ENDPOINT = "/control"
 const WORLD_CELLS_RENDER_ENDPOINT = "/render/stream/cells"
 const WORLD_CYTOKINE_RENDER_ENDPOINT = "/render/stream/cytokines"
 const WORLD_TEXTURE_ENDPOINT = "/render/texture"
 const INTERACTIONS_LOGIN_ENDPOINT = "/interactions/login"
 const INTERACTIONS_STREAM_ENDPOINT = "/interactions/stream"
+const ADMIN_SCENARIO_ENDPOINT = "/admin/scenario"
+const ADMIN_VACCINATE_ENDPOINT = "/admin/vaccinate"
+const ADMIN_IMMUNIZE_ENDPOINT = "/admin/immunize"
+const ADMIN_CLOCK_ENDPOINT = "/admin/clock"
+const ADMIN_SNAPSHOT_ENDPOINT = "/admin/snapshot"
+const ADMIN_LINEAGE_ENDPOINT = "/admin/lineage"
+const ADMIN_EDGES_ENDPOINT = "/admin/edges"
+const METRICS_ENDPOINT = "/metrics"
+const EVENTS_ENDPOINT = "/events"
+const REGISTRY_ENDPOINT = "/registry"
 
-const ORIGIN = "http://localhost/"
-const URL_TEMPLATE = "http://localhost:%v"
-const WEBSOCKET_TEMPLATE = "ws://localhost:%v"
+const ORIGIN_TEMPLATE = "http://%v/"
+const URL_TEMPLATE = "http://%v:%v"
+const WEBSOCKET_TEMPLATE = "ws://%v:%v"
 const WORK_URL_TEMPLATE = WEBSOCKET_TEMPLATE + WORK_ENDPOINT
 const TRANSPORT_URL_TEMPLATE = URL_TEMPLATE + TRANSPORT_ENDPOINT
+const MEMORY_URL_TEMPLATE = "memory://node%v" + TRANSPORT_ENDPOINT
+const DEFAULT_REGISTRY_URL = "http://localhost:3000" + REGISTRY_ENDPOINT
+
+// How long to wait for a node hosted elsewhere to announce itself.
+const REGISTRY_TIMEOUT = 1 * time.Minute
+const REGISTRY_POLL_INTERVAL = 500 * time.Millisecond
+
+// How long a node has to answer a transport request.
+const TRANSPORT_TIMEOUT = 30 * time.Second
+
+// Broken edges are dialed again after a delay that doubles up to the maximum.
+const EDGE_RECONNECT_MIN_BACKOFF = 100 * time.Millisecond
+const EDGE_RECONNECT_MAX_BACKOFF = 30 * time.Second
+const EDGE_HEARTBEAT_INTERVAL = 1 * time.Second
+const EDGE_HEARTBEAT_TIMEOUT = 10 * time.Second // Breaks the edge if unanswered.
+
+// The lineage registry forgets the longest dead cells past this many, and
+// finds a cell by only its most recent render IDs, one per node it visited.
+const LINEAGE_MAX_DEAD = 10000
+const LINEAGE_MAX_RENDER_IDS = 10
 
 const WORLD_BOUNDS = 100
 const NUM_PLANES = 1
@@ -28,6 +59,7 @@ const LINE_WIDTH = 2
 const MIN_BOX_WIDTH = 10
 const MAX_RADIUS = WORLD_BOUNDS / 4
 const MAIN_STAGE_RADIUS = WORLD_BOUNDS / 5
+const OPEN_POINT_ATTEMPTS = 10
 const POSITION_TRACKER_SIZE = 9 * 3
 const SPAWN_DISPLACEMENT = 6
 const RENDER_STREAM_TICK_RATE = time.Second / 10
@@ -51,10 +83,12 @@ const TIMEOUT_SEC = 10 * CELL_CLOCK_RATE
 const WAIT_FOR_WORKER_SEC = 100 * CELL_CLOCK_RATE
 
 const RESULT_BUFFER_SIZE = 10
+const MEMORY_CONNECTION_BUFFER = 100
 const DIFFUSION_TRACKER_BUFFER = 5
 const STREAMING_BUFFER_SIZE = 100
 const RENDER_BUFFER_SIZE = 10
 const CELL_ACTIONS_BUFFER = 10
+const EVENT_BUFFER_SIZE = 100
 
 const INTERACTIONS_TIMEOUT = 1 * CELL_CLOCK_RATE
 
@@ -73,6 +107,9 @@ const CYTOKINE_CHEMO_TAXIS = 30
 const CYTOKINE_CELL_STRESSED = 50
 const CYTOKINE_ANTIGEN_PRESENT = 30
 const CYTOKINE_CYTOTOXINS = 15
+const CYTOKINE_ANAPHYLATOXIN = 20
+const CYTOKINE_ANTI_INFLAMMATORY = 30
+const CYTOKINE_SUPPRESSION_THRESHOLD = 10 // Anti-inflammatory cytokine that keeps a cell from activating.
 const CYTOTOXIN_DAMAGE_THRESHOLD = 25
 const CYTOKINE_SENSE_RANGE = 2
 
@@ -85,6 +122,9 @@ const SEED_GRANULOCYTE_COLONY_STIMULATING_FACTOR = 100
 const SEED_MACROPHAGE_COLONY_STIMULATING_FACTOR = 100
 const SEED_INTERLEUKIN_3 = 100
 const SEED_INTERLEUKIN_2 = 0
+const SEED_INTERLEUKIN_10 = 0
+
+const RED_BLOOD_LIFE_SPAN = 30 * time.Minute // Then retired by the spleen.
 
 const LEUKOCYTE_STEM_CELL_LIFE_SPAN = 1 * time.Hour
 const LEUKOCYTE_STEM_CELL_TRANSPORT_SPAN = 10 * time.Second
@@ -93,7 +133,6 @@ const NEUTROPHIL_LIFE_SPAN = 5 * time.Minute
 const NEUTROPHIL_TRANSPORT_SPAN = 1 * time.Minute
 const NEUTROPHIL_NET_DAMAGE = 10
 const NEUTROPHIL_NETOSIS_THRESHOLD = 100
-const NEUTROPHIL_OPSONIN_TIME = 15 * time.Minute
 
 const MACROPHAGE_LIFE_SPAN = 5 * time.Hour
 const MACROPHAGE_TRANSPORT_SPAN = 3 * time.Minute
@@ -101,6 +140,7 @@ const MACROPHAGE_INFLAMMATION_CONSUMPTION = 1
 const MACROPHAGE_PROMOTE_GROWTH_THRESHOLD = 1000
 const MACROPHAGE_STIMULATE_CELL_GROWTH = 1
 const MACROPHAGE_ACTIVATION_COOLDOWN = 30 * time.Second
+const MACROPHAGE_VIRAL_FILTER_RATE = 100 // Antibody coated viral load cleared per spleen macrophage, per work.
 
 const DENDRITIC_CELL_LIFE_SPAN = 15 * time.Minute
 const DENDRITIC_CELL_TRANSPORT_SPAN = 30 * time.Second
@@ -110,6 +150,10 @@ const NATURALKILLER_LIFE_SPAN = 5 * time.Hour
 const NATURALKILLER_TRANSPORT_SPAN = 3 * time.Minute
 const NATURAL_KILLER_DAMAGE_KILL_THRESHOLD = 0.75 * MAX_DAMAGE
 
+const THYMOCYTE_LIFE_SPAN = 5 * time.Minute
+const THYMOCYTE_RECEPTOR_COUNT = 650
+const THYMOCYTE_SELECTIONS = 5 // Self antigens to recognize, without binding, to mature.
+
 const VIRGIN_TCELL_LIFE_SPAN = 525600 * time.Minute
 const VIRGIN_TCELL_TRANSPORT_SPAN = 525600 * time.Minute
 const VIRGIN_TCELL_COUNT = 100
@@ -121,6 +165,11 @@ const HELPER_TCELL_TRANSPORT_SPAN = 1 * time.Minute
 const KILLER_TCELL_LIFE_SPAN = 3 * time.Minute
 const KILLER_TCELL_TRANSPORT_SPAN = 10 * time.Second
 
+const REGULATORY_TCELL_LIFE_SPAN = 15 * time.Minute
+const REGULATORY_TCELL_TRANSPORT_SPAN = 1 * time.Minute
+const REGULATORY_TCELL_IL2_CONSUMPTION = 2 // IL-2 soaked up per work, before other T cells can divide on it.
+const REGULATORY_TCELL_INFLAMMATION_CONSUMPTION = 2
+
 const BCELL_LIFE_SPAN = 525600 * time.Minute
 const BCELL_TRANSPORT_SPAN = 525600 * time.Minute
 const BCELL_COUNT = 100
@@ -130,6 +179,21 @@ const EFFECTOR_BCELL_LIFE_SPAN = 15 * time.Minute
 const EFFECTOR_BCELL_TRANSPORT_SPAN = 15 * time.Minute
 const EFFECTOR_BCELL_ANTIBODY_PRODUCTION = 10
 
+const ANTIBODY_MAX_AFFINITY = 100
+const ANTIBODY_BASE_AFFINITY = 20 // Of the antibodies a naive B cell makes.
+const SOMATIC_HYPERMUTATION_INTERVAL = 30 * time.Second
+const SOMATIC_HYPERMUTATION_STEP = 10 // The most a mutation changes affinity by.
+
+const MEMORY_TCELL_LIFE_SPAN = 525600 * time.Minute
+const MEMORY_TCELL_TRANSPORT_SPAN = 2 * time.Minute // Recirculates through the lymph nodes.
+
+const MEMORY_BCELL_LIFE_SPAN = 525600 * time.Minute
+const MEMORY_BCELL_TRANSPORT_SPAN = 2 * time.Minute
+
+const MEMORY_CELL_CLONES = 3     // Effector cells a memory cell divides into at once.
+const MEMORY_CELLS_PER_CLONE = 1 // Memory cells a naive lymphocyte leaves behind, however often it divides.
+const MEMORY_CELL_REST = 5 * time.Minute
+
 const BACTERIA_ENERGY_MITOSIS_THRESHOLD = 100
 const DEFAULT_BACTERIA_GENERATION_DURATION = CELL_CLOCK_RATE * BACTERIA_ENERGY_MITOSIS_THRESHOLD
 const GUT_BACTERIA_GENERATION_DURATION = 3 * CELL_CLOCK_RATE * BACTERIA_ENERGY_MITOSIS_THRESHOLD
@@ -160,6 +224,21 @@ const CREATININE_PRODUCTION = 1
 const CREATININE_FILTRATE = 10
 const CREATININE_GROWTH_THRESHOLD = 100
 const CREATININE_LIGAND_GROWTH = 10
+const BACTERIA_AMMONIA_PRODUCTION = 1
+const AMMONIA_DETOXIFY = 10
+const GLYCOGEN_UNIT = 100
+const INSULIN_GLUCOSE_RATIO = 100       // Glucose sensed per unit of insulin beta cells secrete.
+const INSULIN_UPTAKE = 2                // Insulin bound to take up glucose, or to store it.
+const INSULIN_STORE_THRESHOLD = 200     // Insulin above which the liver stores glucose.
+const GLUCAGON_GLUCOSE_THRESHOLD = 1000 // Glucose below which alpha cells secrete glucagon.
+const GLUCAGON_SECRETION = 10
+const GLUCAGON_RELEASE_THRESHOLD = 10 // Glucagon used up for the liver to release glucose.
+const COMPLEMENT_THRESHOLD = 50
+const COMPLEMENT_PRODUCTION = 10
+const COMPLEMENT_FIXATION = 2                   // Complement a bacterium binds at a time, twice as much if antibodies mark it.
+const COMPLEMENT_OPSONIN_THRESHOLD = 10         // Opsonins for phagocytes to engulf a bacterium.
+const COMPLEMENT_MEMBRANE_ATTACK_THRESHOLD = 30 // Opsonins above which membrane attack complexes form.
+const COMPLEMENT_MEMBRANE_ATTACK_DAMAGE = 5
 
 const LIGAND_GROWTH_THRESHOLD = 20
 const LIGAND_HUNGER_THRESHOLD = 25
@@ -168,18 +247,23 @@ const LIGAND_INFLAMMATION_CELL_DAMAGE = 3
 const LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD = 50
 const LIGAND_INFLAMMATION_LEUKOCYTE = 10
 const LIGAND_INFLAMMATION_MAX = 1000
+const VACCINE_ADJUVANT_INFLAMMATION = 2 * LIGAND_LEUKOCYTE_INFLAMMATION_THRESHOLD
 const HORMONE_CSF_THRESHOLD = 5    // Produce Myeloblast --> Neutrophils.
 const HORMONE_M_CSF_THRESHOLD = 20 // Produce Monocyte --> Macrophage, Dendritic
 const HORMONE_IL3_THRESHOLD = 20   // Produce Lymphoblast --> Natural Killer
 const HORMONE_MACROPHAGE_DROP = 5
 const HORMONE_TCELL_DROP = 10
 const HORMONE_IL2_THRESHOLD = 10
+const HORMONE_IL10_DROP = 2
+const HORMONE_IL10_THRESHOLD = 10 // IL-10 that holds back a T cell about to divide on IL-2.
+const HORMONE_IL10_BINDING = 1    // IL-10 used up each time it does.
 const BRAIN_GLUCOSE_THRESHOLD = 1000
 const BRAIN_VITAMIN_THRESHOLD = 100
 const BRAIN_O2_THRESHOLD = 1000
 const BRAIN_CO2_THRESHOLD = 100
 const DAMAGE_CO2_THRESHOLD = 5000
 const DAMAGE_CREATININE_THRESHOLD = 1000
+const DAMAGE_AMMONIA_THRESHOLD = 1000
 const DAMAGE_MITOSIS_THRESHOLD = 50
 const MAX_DAMAGE = 100
 const MAX_REPAIR = 10
@@ -21,7 +21,7 @@ type Cytokine struct {
 	dissipationRate uint8
 }
 
-func MakeCytokine(pt image.Point, cytokine CytokineType, concentration uint8) *Cytokine {
+func MakeCytokine(pt image.Point, cytokine CytokineType, concentration uint8, now time.Time) *Cytokine {
 	return &Cytokine{
 		Circle: Circle{
 			center: pt,
@@ -29,7 +29,7 @@ func MakeCytokine(pt image.Point, cytokine CytokineType, concentration uint8) *C
 		},
 		cytokine:        cytokine,
 		concentration:   concentration,
-		lastTick:        time.Now(),
+		lastTick:        now,
 		tickRate:        CYTOKINE_TICK_RATE,
 		dissipationRate: CYTOKINE_DISSIPATION_RATE,
 	}
@@ -63,11 +63,10 @@ func (c *Cytokine) Sub(x uint8) (removed uint8) {
 	return
 }
 
-func (c *Cytokine) Tick() {
+func (c *Cytokine) Tick(now time.Time) {
 	c.Lock()
 	defer c.Unlock()
-	currTime := time.Now()
-	if currTime.After(c.lastTick.Add(c.tickRate)) {
+	if now.After(c.lastTick.Add(c.tickRate)) {
 		if c.concentration <= c.dissipationRate {
 			c.concentration = 0
 			c.radius = 0
@@ -75,7 +74,7 @@ func (c *Cytokine) Tick() {
 			c.concentration -= c.dissipationRate
 		}
 		c.radius += CYTOKINE_EXPANSION_RATE
-		c.lastTick = currTime
+		c.lastTick = now
 	}
 }
 
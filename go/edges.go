@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Edge struct {
+	transportCount int64 // Updated atomically, first so that it's 64-bit aligned.
+	sync.RWMutex
+	edgeType       EdgeType
+	workConnection WorkConnection
+	transportUrl   string
+	healthy        bool
+	removed        bool
+	latency        time.Duration // Round trip of the last heartbeat.
+	lastSeen       time.Time     // When anything last came back over the edge.
+	heartbeatSent  time.Time     // Zero unless a heartbeat awaits its reply.
+}
+
+func (e *Edge) Healthy() bool {
+	e.RLock()
+	defer e.RUnlock()
+	return e.healthy
+}
+
+// SendWork sends over the edge, and breaks it if the send fails so that it is
+// dialed again.
+func (e *Edge) SendWork(work Work, diffusion *DiffusionSocketData) error {
+	e.RLock()
+	connection, healthy := e.workConnection, e.healthy
+	e.RUnlock()
+	if !healthy {
+		return &ConnectionError{Op: "send", Address: e.transportUrl, Err: ErrEdgeDown}
+	}
+	err := connection.SendWork(work, diffusion)
+	if err != nil {
+		e.Break(connection)
+	}
+	return err
+}
+
+// Break marks the edge down, unless it has already been dialed again.
+func (e *Edge) Break(connection WorkConnection) {
+	e.Lock()
+	if e.workConnection == connection {
+		e.healthy = false
+	}
+	e.Unlock()
+	connection.Close()
+}
+
+// Reconnect puts a newly dialed connection in place, unless the edge was
+// removed in the meantime.
+func (e *Edge) Reconnect(connection WorkConnection) bool {
+	e.Lock()
+	defer e.Unlock()
+	if e.removed {
+		connection.Close()
+		return false
+	}
+	e.workConnection = connection
+	e.healthy = true
+	e.lastSeen = time.Now()
+	e.heartbeatSent = time.Time{}
+	return true
+}
+
+func (e *Edge) Remove() {
+	e.Lock()
+	e.removed = true
+	e.healthy = false
+	connection := e.workConnection
+	e.Unlock()
+	connection.Close()
+}
+
+func (e *Edge) Removed() bool {
+	e.RLock()
+	defer e.RUnlock()
+	return e.removed
+}
+
+// Seen records a response coming back over the edge, and the latency if it
+// answers a heartbeat.
+func (e *Edge) Seen(work Work) {
+	e.Lock()
+	defer e.Unlock()
+	e.lastSeen = time.Now()
+	if work.workType == WorkType_nothing && !e.heartbeatSent.IsZero() {
+		e.latency = e.lastSeen.Sub(e.heartbeatSent)
+		e.heartbeatSent = time.Time{}
+	}
+}
+
+// Heartbeat sends a request for nothing, which the other node answers right
+// away, unless the last one is still unanswered. Work requests are answered in
+// order, so the latency includes any wait for a worker. A peer that leaves a
+// heartbeat unanswered for too long is dialed again.
+func (e *Edge) Heartbeat() error {
+	e.Lock()
+	if !e.healthy {
+		e.Unlock()
+		return nil
+	}
+	if !e.heartbeatSent.IsZero() {
+		if time.Since(e.heartbeatSent) < EDGE_HEARTBEAT_TIMEOUT {
+			e.Unlock()
+			return nil
+		}
+		e.heartbeatSent = time.Time{}
+		connection := e.workConnection
+		e.Unlock()
+		e.Break(connection)
+		return &ConnectionError{Op: "receive", Address: e.transportUrl, Err: ErrHeartbeatTimeout}
+	}
+	e.heartbeatSent = time.Now()
+	e.Unlock()
+	return e.SendWork(Work{workType: WorkType_nothing}, nil)
+}
+
+func (n *Node) Edges() []*Edge {
+	n.RLock()
+	defer n.RUnlock()
+	edges := make([]*Edge, len(n.edges))
+	copy(edges, n.edges)
+	return edges
+}
+
+// Disconnect removes every edge to the node at transportUrl.
+func (n *Node) Disconnect(transportUrl string) (removed int) {
+	n.Lock()
+	var edges []*Edge
+	for _, edge := range n.edges {
+		if edge.transportUrl == transportUrl {
+			edge.Remove()
+			removed++
+		} else {
+			edges = append(edges, edge)
+		}
+	}
+	n.edges = edges
+	n.Unlock()
+	return
+}
+
+// MaintainEdge processes the responses that come back over an edge, and when
+// its connection breaks, dials it again with exponential backoff.
+func (n *Node) MaintainEdge(ctx context.Context, edge *Edge) {
+	backoff := EDGE_RECONNECT_MIN_BACKOFF
+	for {
+		edge.RLock()
+		connection := edge.workConnection
+		edge.RUnlock()
+		err := n.ProcessIncomingWorkResponses(ctx, edge, connection)
+		edge.Break(connection)
+		if ctx.Err() != nil || edge.Removed() {
+			return
+		}
+		fmt.Println(n, "lost edge to", edge.transportUrl+":", err)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			if edge.Removed() {
+				return
+			}
+			connection, err = n.transport.Dial(ctx, n, edge.transportUrl)
+			backoff *= 2
+			if backoff > EDGE_RECONNECT_MAX_BACKOFF {
+				backoff = EDGE_RECONNECT_MAX_BACKOFF
+			}
+			if err == nil {
+				break
+			}
+			if n.verbose {
+				fmt.Println(n, "unable to reconnect:", err)
+			}
+		}
+		if !edge.Reconnect(connection) {
+			return
+		}
+		fmt.Println(n, "reconnected to", edge.transportUrl)
+		backoff = EDGE_RECONNECT_MIN_BACKOFF
+	}
+}
+
+func (n *Node) MonitorEdge(ctx context.Context, edge *Edge) {
+	ticker := time.NewTicker(EDGE_HEARTBEAT_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if edge.Removed() {
+				return
+			}
+			if err := edge.Heartbeat(); err != nil && n.verbose {
+				fmt.Println(n, "heartbeat failed:", err)
+			}
+		}
+	}
+}
+
+type EdgeStatus struct {
+	From       string     `json:"from"`
+	To         string     `json:"to"`
+	EdgeType   EdgeType   `json:"edgeType"`
+	Healthy    bool       `json:"healthy"`
+	LatencyMs  float64    `json:"latencyMs"`
+	LastSeen   *time.Time `json:"lastSeen,omitempty"`
+	Transports int64      `json:"transports"`
+}
+
+func (e *Edge) Status(from string, to string) EdgeStatus {
+	e.RLock()
+	defer e.RUnlock()
+	status := EdgeStatus{
+		From:       from,
+		To:         to,
+		EdgeType:   e.edgeType,
+		Healthy:    e.healthy,
+		LatencyMs:  float64(e.latency) / float64(time.Millisecond),
+		Transports: atomic.LoadInt64(&e.transportCount),
+	}
+	if !e.lastSeen.IsZero() {
+		lastSeen := e.lastSeen
+		status.LastSeen = &lastSeen
+	}
+	return status
+}
+
+// NodeNames maps the address of every node, hosted here or elsewhere, to its
+// name.
+func (b *Body) NodeNames() map[string]string {
+	names := map[string]string{}
+	if b.directory != nil {
+		if listing, err := b.directory.Listing(); err == nil {
+			for name, address := range listing.Nodes {
+				names[address] = name
+			}
+		}
+	}
+	for _, node := range b.allNodes {
+		names[node.transportUrl] = node.name
+	}
+	return names
+}
+
+func (b *Body) EdgeStatuses() (statuses []EdgeStatus) {
+	names := b.NodeNames()
+	for _, organ := range ORGANS {
+		for _, node := range *b.OrganNodes(organ) {
+			for _, edge := range node.Edges() {
+				statuses = append(statuses, edge.Status(node.name, names[edge.transportUrl]))
+			}
+		}
+	}
+	return
+}
+
+func (b *Body) checkEdgeNodes(node1, node2 string) error {
+	for _, name := range []string{node1, node2} {
+		if b.FindNode(name) == nil && !b.remote[name] {
+			return fmt.Errorf("edges: unknown node %q", name)
+		}
+	}
+	return nil
+}
+
+// AddEdge connects the nodes of an edge that are hosted here to each other.
+func (b *Body) AddEdge(ctx context.Context, edge EdgeSpec) error {
+	if err := b.checkEdgeNodes(edge.Node1, edge.Node2); err != nil {
+		return err
+	}
+	if err := b.ConnectByName(ctx, edge.Node1, edge.Node2, edge.ToNode2); err != nil {
+		return err
+	}
+	return b.ConnectByName(ctx, edge.Node2, edge.Node1, edge.ToNode1)
+}
+
+// RemoveEdge severs every edge between two nodes, in both directions.
+func (b *Body) RemoveEdge(ctx context.Context, node1, node2 string) error {
+	if err := b.checkEdgeNodes(node1, node2); err != nil {
+		return err
+	}
+	removed := 0
+	for _, pair := range [][2]string{{node1, node2}, {node2, node1}} {
+		from := b.FindNode(pair[0])
+		if from == nil {
+			continue
+		}
+		address, err := b.NodeAddress(ctx, pair[1])
+		if err != nil {
+			return err
+		}
+		removed += from.Disconnect(address)
+	}
+	if removed == 0 {
+		return fmt.Errorf("edges: no edge between %q and %q", node1, node2)
+	}
+	return nil
+}
+
+// HandleEdgesRequest lists every edge on GET, adds the edge POSTed in the
+// anatomy's edge format, and removes the edges between two nodes on DELETE,
+// e.g. /admin/edges?node1=Blood%20-%20Left%20Arm&node2=Left%20Arm%20Muscle.
+func (b *Body) HandleEdgesRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(b.EdgeStatuses())
+	case http.MethodPost:
+		var edge EdgeSpec
+		if err := json.NewDecoder(r.Body).Decode(&edge); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := b.AddEdge(ctx, edge); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, "Connected %v and %v", edge.Node1, edge.Node2)
+	case http.MethodDelete:
+		node1, node2 := r.URL.Query().Get("node1"), r.URL.Query().Get("node2")
+		if err := b.RemoveEdge(ctx, node1, node2); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, "Severed %v and %v", node1, node2)
+	default:
+		http.Error(w, "Edges accept GET, POST and DELETE.", http.StatusMethodNotAllowed)
+	}
+}
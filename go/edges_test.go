@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAdminEdges(t *testing.T) {
+	ctx := context.Background()
+	body, err := RestoreBody(ctx, &Snapshot{
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Nodes: []*NodeSnapshot{
+			{Name: "Edge Blood", Organ: "blood"},
+			{Name: "Edge Muscle", Organ: "muscle"},
+		},
+		Edges: []*EdgeSnapshot{
+			{From: "Edge Blood", To: "Edge Muscle", EdgeType: int32(muscular)},
+			{From: "Edge Muscle", To: "Edge Blood", EdgeType: int32(cardiovascular)},
+		},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := func(method, target, data string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		body.HandleEdgesRequest(ctx, w, httptest.NewRequest(method, target, strings.NewReader(data)))
+		return w
+	}
+	list := func() (statuses []EdgeStatus) {
+		w := request(http.MethodGet, ADMIN_EDGES_ENDPOINT, "")
+		if err := json.NewDecoder(w.Body).Decode(&statuses); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	// Wait for a heartbeat to come back.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		statuses := list()
+		if len(statuses) != 2 {
+			t.Fatalf("Expected 2 edges, got: %+v", statuses)
+		}
+		if statuses[0].LatencyMs > 0 && statuses[0].Healthy && statuses[0].LastSeen != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected a healthy edge with a latency, got: %+v", statuses[0])
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	sever := ADMIN_EDGES_ENDPOINT + "?node1=" + url.QueryEscape("Edge Blood") + "&node2=" + url.QueryEscape("Edge Muscle")
+	if w := request(http.MethodDelete, sever, ""); w.Code != http.StatusOK {
+		t.Fatalf("Expected the edges to be severed, got: %v %v", w.Code, w.Body)
+	}
+	if statuses := list(); len(statuses) != 0 {
+		t.Errorf("Expected no edges, got: %+v", statuses)
+	}
+	if w := request(http.MethodDelete, sever, ""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected a 404 severing a missing edge, got: %v", w.Code)
+	}
+
+	w := request(http.MethodPost, ADMIN_EDGES_ENDPOINT, `{"node1": "Edge Blood", "node2": "Edge Muscle", "toNode2": "muscular", "toNode1": "cardiovascular"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected the edges to be added, got: %v %v", w.Code, w.Body)
+	}
+	statuses := list()
+	if len(statuses) != 2 || statuses[0].To != "Edge Muscle" || statuses[0].EdgeType != muscular || !statuses[0].Healthy {
+		t.Errorf("Expected the blood to reach the muscle again, got: %+v", statuses)
+	}
+	if w := request(http.MethodPost, ADMIN_EDGES_ENDPOINT, `{"node1": "Edge Blood", "node2": "Spleen"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected a 400 for an unknown node, got: %v", w.Code)
+	}
+}
+
+func TestHeartbeatTimeout(t *testing.T) {
+	// Nothing serves the far end, like a peer that hung.
+	connection, peer := MakeMemoryConnections("Heartbeat Blood", "Heartbeat Muscle")
+	edge := &Edge{transportUrl: "Heartbeat Muscle", workConnection: connection, healthy: true}
+	if err := edge.Heartbeat(); err != nil || len(peer.in) != 1 {
+		t.Fatalf("Expected a heartbeat to be sent, got: %v, %v sent", err, len(peer.in))
+	}
+	if err := edge.Heartbeat(); err != nil || len(peer.in) != 1 || !edge.Healthy() {
+		t.Fatalf("Expected to wait on the unanswered heartbeat, got: %v, %v sent", err, len(peer.in))
+	}
+
+	edge.Lock()
+	edge.heartbeatSent = time.Now().Add(-EDGE_HEARTBEAT_TIMEOUT)
+	edge.Unlock()
+	if err := edge.Heartbeat(); !errors.Is(err, ErrHeartbeatTimeout) {
+		t.Errorf("Expected the heartbeat to time out, got: %v", err)
+	}
+	if edge.Healthy() || !edge.heartbeatSent.IsZero() {
+		t.Errorf("Expected the edge to break, got healthy %v, sent at %v", edge.Healthy(), edge.heartbeatSent)
+	}
+	if _, _, err := connection.ReceiveWork(); err == nil {
+		t.Errorf("Expected the connection to be closed, so that the edge is dialed again")
+	}
+
+	// Dialed again, the edge sends heartbeats again.
+	redialed, peer := MakeMemoryConnections("Heartbeat Blood", "Heartbeat Muscle")
+	edge.Reconnect(redialed)
+	if err := edge.Heartbeat(); err != nil || len(peer.in) != 1 {
+		t.Errorf("Expected a heartbeat over the new connection, got: %v, %v sent", err, len(peer.in))
+	}
+}
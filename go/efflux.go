@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 	"net/http"
 	_ "net/http/pprof"
@@ -10,6 +12,111 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "events" {
+		if err := RunEventsCommand(os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "node" {
+		if err := RunNodeCommand(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	bodyPath := flag.String("body", "", "Path to an anatomy JSON file, defaults to the built-in human body.")
+	scenarioPath := flag.String("scenario", "", "Path to an infection scenario JSON file, defaults to the built-in pneumonia.")
+	programsPath := flag.String("programs", "", "Path to a JSON file of cell programs that replace the built-in ones by name.")
+	seedFlag := flag.Int64("seed", 0, "Seed for a reproducible run, defaults to the current time.")
+	restorePath := flag.String("restore", "", "Path to a snapshot to resume instead of generating a body.")
+	eventsPath := flag.String("events", "", "Path to append the JSONL event log to.")
+	transportFlag := flag.String("transport", "http", "How nodes talk: http gives each node a server for the UI, memory keeps everything in process.")
+	remoteNodes := flag.String("remote", "", "Comma separated names of nodes hosted by `efflux node` processes instead of this one.")
+	host := flag.String("host", "localhost", "Host other processes reach this one's nodes at.")
+	port := flag.Int("port", 8000, "Port of the first node, the rest follow.")
+	flag.Parse()
+	// Any seed can be passed back, including 0, so check it was set at all.
+	seedSet := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+	if seedSet {
+		SetSeed(*seedFlag)
+	}
+	fmt.Println("Seed:", Seed())
+	SetAddresses(*host, *port)
+	var err error
+	anatomy := DefaultAnatomy()
+	if *bodyPath != "" {
+		anatomy, err = LoadAnatomy(*bodyPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	scenario := DefaultScenario()
+	if *scenarioPath != "" {
+		scenario, err = LoadScenario(*scenarioPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *programsPath != "" {
+		programs, err := LoadPrograms(*programsPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		UsePrograms(programs)
+	}
+	var transport Transporter
+	switch *transportFlag {
+	case "http":
+		transport = InitializeHTTPTransport()
+	case "memory":
+		transport = InitializeMemoryTransport()
+	default:
+		log.Fatalf("Unknown transport: %q", *transportFlag)
+	}
+	remote := ParseNodeNames(*remoteNodes)
+	var hosted map[string]bool
+	if remote != nil {
+		if *transportFlag != "http" {
+			log.Fatal("Remote nodes need the http transport")
+		}
+		hosted = map[string]bool{}
+		for _, spec := range anatomy.Nodes {
+			hosted[spec.Name] = !remote[spec.Name]
+		}
+		for name := range remote {
+			if _, ok := hosted[name]; !ok {
+				log.Fatalf("Unknown remote node: %q", name)
+			}
+		}
+	}
+	var snapshot *Snapshot
+	if *restorePath != "" {
+		if remote != nil {
+			log.Fatal("Snapshots can't be restored with remote nodes")
+		}
+		snapshot, err = LoadSnapshot(*restorePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *scenarioPath == "" {
+			// The snapshot already holds its infections.
+			scenario = &Scenario{}
+		}
+	}
+	var eventsFile *os.File
+	if *eventsPath != "" {
+		eventsFile, err = os.OpenFile(*eventsPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer eventsFile.Close()
+	}
+
 	MakeBaseImage().Download()
 	ctx, cancel := context.WithCancel(context.Background())
 	// Setting up signal capturing
@@ -20,8 +127,11 @@ func main() {
 		cancel()
 	}()
 
-	fs := http.FileServer(http.Dir("./public"))
-	server := &http.Server{Addr: ":3000", Handler: fs}
+	mux := http.NewServeMux()
+	mux.Handle("/", http.FileServer(http.Dir("./public")))
+	registry := InitializeNodeRegistry(Seed())
+	mux.HandleFunc(REGISTRY_ENDPOINT, registry.HandleRegistryRequest)
+	server := &http.Server{Addr: ":3000", Handler: mux}
 
 	go func() {
 		log.Println(http.ListenAndServe("localhost:6060", nil))
@@ -32,12 +142,42 @@ func main() {
 			log.Fatal(err)
 		}
 	}()
-	GenerateBody(ctx)
+	var body *Body
+	if snapshot != nil {
+		body, err = RestoreBody(ctx, snapshot, transport)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("Restored snapshot, seed:", Seed())
+	} else {
+		body, err = InitializeBodyPart(ctx, anatomy, transport, hosted, registry)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if eventsFile != nil {
+		body.events.SetWriter(eventsFile)
+	}
+	if snapshot == nil {
+		body.GenerateCellsAndStart(ctx, anatomy)
+	}
+	body.RegisterAdminEndpoints(ctx, mux)
+	mux.HandleFunc(METRICS_ENDPOINT, body.HandleMetricsRequest)
+	mux.HandleFunc(EVENTS_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		body.events.StreamEvents(ctx, w, r)
+	})
+	err = body.RunScenario(ctx, scenario)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Waiting for SIGINT (kill -2)
 	select {
 	case <-signalChan: // first signal, cancel context
 		cancel()
+	case err := <-transport.Errors(): // a node can't be reached, shut down
+		log.Println(err)
+		return
 	case <-ctx.Done():
 		defer cancel()
 		if err := server.Shutdown(ctx); err != nil {
@@ -23,31 +23,39 @@ const (
 type CellType int32
 
 const (
-	CellType_CellTypeUnknown     CellType = 0
-	CellType_Bacteria            CellType = 1 // A baseline prokaryotic cell.
-	CellType_Bacteroidota        CellType = 2 // Bacteria that synthesize vitamins in the gut.
-	CellType_RedBlood            CellType = 3
-	CellType_Neuron              CellType = 4
-	CellType_Cardiomyocyte       CellType = 5  // Heart Cell
-	CellType_Pneumocyte          CellType = 6  // Pulmonary Cell
-	CellType_Myocyte             CellType = 7  // Muscle Cell
-	CellType_Keratinocyte        CellType = 8  // Skin Cell
-	CellType_Enterocyte          CellType = 9  // Gut Lining Cell
-	CellType_Podocyte            CellType = 10 // Kidney Cell
-	CellType_Hemocytoblast       CellType = 11 // Bone Marrow Stem Cell, spawns Lymphoblast, Monocyte, and Myeloblast
-	CellType_Lymphoblast         CellType = 12 // Stem Cell, becomes NK, B cells, T cells
-	CellType_Myeloblast          CellType = 13 // Stem Cell, becomes Neutrophil (also Macrophages and Dendritic cells but n
-	CellType_Monocyte            CellType = 14 // Stem Cell, becomes Macrophages and Dendritic cells
-	CellType_Macrophagocyte      CellType = 15 // Macrophage
-	CellType_Dendritic           CellType = 16 // Dendritic Cells
-	CellType_Neutrocyte          CellType = 17 // Neutrophils
-	CellType_NaturalKillerCell   CellType = 18 // Natural Killer Cell
-	CellType_VirginTLymphocyte   CellType = 19 // Virgin T Cell
-	CellType_HelperTLymphocyte   CellType = 20 // Helper T Cell
-	CellType_KillerTLymphocyte   CellType = 21 // Killer T Cell
-	CellType_BLymphocyte         CellType = 22 // B Cell
-	CellType_EffectorBLymphocyte CellType = 23 // Plasma Cell
-	CellType_ViralLoadCarrier    CellType = 24 // A dummy cell that carries a virus. Always make sure this is last.
+	CellType_CellTypeUnknown       CellType = 0
+	CellType_Bacteria              CellType = 1 // A baseline prokaryotic cell.
+	CellType_Bacteroidota          CellType = 2 // Bacteria that synthesize vitamins in the gut.
+	CellType_RedBlood              CellType = 3
+	CellType_Neuron                CellType = 4
+	CellType_Cardiomyocyte         CellType = 5  // Heart Cell
+	CellType_Pneumocyte            CellType = 6  // Pulmonary Cell
+	CellType_Myocyte               CellType = 7  // Muscle Cell
+	CellType_Keratinocyte          CellType = 8  // Skin Cell
+	CellType_Enterocyte            CellType = 9  // Gut Lining Cell
+	CellType_Podocyte              CellType = 10 // Kidney Cell
+	CellType_Hemocytoblast         CellType = 11 // Bone Marrow Stem Cell, spawns Lymphoblast, Monocyte, and Myeloblast
+	CellType_Lymphoblast           CellType = 12 // Stem Cell, becomes NK, B cells, T cells
+	CellType_Myeloblast            CellType = 13 // Stem Cell, becomes Neutrophil (also Macrophages and Dendritic cells but n
+	CellType_Monocyte              CellType = 14 // Stem Cell, becomes Macrophages and Dendritic cells
+	CellType_Macrophagocyte        CellType = 15 // Macrophage
+	CellType_Dendritic             CellType = 16 // Dendritic Cells
+	CellType_Neutrocyte            CellType = 17 // Neutrophils
+	CellType_NaturalKillerCell     CellType = 18 // Natural Killer Cell
+	CellType_VirginTLymphocyte     CellType = 19 // Virgin T Cell
+	CellType_HelperTLymphocyte     CellType = 20 // Helper T Cell
+	CellType_KillerTLymphocyte     CellType = 21 // Killer T Cell
+	CellType_BLymphocyte           CellType = 22 // B Cell
+	CellType_EffectorBLymphocyte   CellType = 23 // Plasma Cell
+	CellType_ViralLoadCarrier      CellType = 24 // A dummy cell that carries a virus.
+	CellType_Hepatocyte            CellType = 25 // Liver Cell
+	CellType_ThymicEpithelial      CellType = 26 // Thymus Cell, presents self proteins to Thymocytes
+	CellType_Thymocyte             CellType = 27 // T Cell in selection, becomes a Virgin T Cell if it survives
+	CellType_PancreaticBeta        CellType = 28 // Pancreas Cell, secretes insulin when glucose runs high
+	CellType_PancreaticAlpha       CellType = 29 // Pancreas Cell, secretes glucagon when glucose runs low
+	CellType_MemoryTLymphocyte     CellType = 30 // Memory T Cell, left behind by an activated Virgin T Cell
+	CellType_MemoryBLymphocyte     CellType = 31 // Memory B Cell, left behind by an activated B Cell
+	CellType_RegulatoryTLymphocyte CellType = 32 // Regulatory T Cell, soaks up IL-2 and resolves inflammation
 )
 
 // Enum value maps for CellType.
@@ -78,33 +86,49 @@ var (
 		22: "BLymphocyte",
 		23: "EffectorBLymphocyte",
 		24: "ViralLoadCarrier",
+		25: "Hepatocyte",
+		26: "ThymicEpithelial",
+		27: "Thymocyte",
+		28: "PancreaticBeta",
+		29: "PancreaticAlpha",
+		30: "MemoryTLymphocyte",
+		31: "MemoryBLymphocyte",
+		32: "RegulatoryTLymphocyte",
 	}
 	CellType_value = map[string]int32{
-		"CellTypeUnknown":     0,
-		"Bacteria":            1,
-		"Bacteroidota":        2,
-		"RedBlood":            3,
-		"Neuron":              4,
-		"Cardiomyocyte":       5,
-		"Pneumocyte":          6,
-		"Myocyte":             7,
-		"Keratinocyte":        8,
-		"Enterocyte":          9,
-		"Podocyte":            10,
-		"Hemocytoblast":       11,
-		"Lymphoblast":         12,
-		"Myeloblast":          13,
-		"Monocyte":            14,
-		"Macrophagocyte":      15,
-		"Dendritic":           16,
-		"Neutrocyte":          17,
-		"NaturalKillerCell":   18,
-		"VirginTLymphocyte":   19,
-		"HelperTLymphocyte":   20,
-		"KillerTLymphocyte":   21,
-		"BLymphocyte":         22,
-		"EffectorBLymphocyte": 23,
-		"ViralLoadCarrier":    24,
+		"CellTypeUnknown":       0,
+		"Bacteria":              1,
+		"Bacteroidota":          2,
+		"RedBlood":              3,
+		"Neuron":                4,
+		"Cardiomyocyte":         5,
+		"Pneumocyte":            6,
+		"Myocyte":               7,
+		"Keratinocyte":          8,
+		"Enterocyte":            9,
+		"Podocyte":              10,
+		"Hemocytoblast":         11,
+		"Lymphoblast":           12,
+		"Myeloblast":            13,
+		"Monocyte":              14,
+		"Macrophagocyte":        15,
+		"Dendritic":             16,
+		"Neutrocyte":            17,
+		"NaturalKillerCell":     18,
+		"VirginTLymphocyte":     19,
+		"HelperTLymphocyte":     20,
+		"KillerTLymphocyte":     21,
+		"BLymphocyte":           22,
+		"EffectorBLymphocyte":   23,
+		"ViralLoadCarrier":      24,
+		"Hepatocyte":            25,
+		"ThymicEpithelial":      26,
+		"Thymocyte":             27,
+		"PancreaticBeta":        28,
+		"PancreaticAlpha":       29,
+		"MemoryTLymphocyte":     30,
+		"MemoryBLymphocyte":     31,
+		"RegulatoryTLymphocyte": 32,
 	}
 )
 
@@ -138,43 +162,55 @@ func (CellType) EnumDescriptor() ([]byte, []int) {
 type WorkType int32
 
 const (
-	WorkType_nothing   WorkType = 0
-	WorkType_diffusion WorkType = 1
-	WorkType_cover     WorkType = 2 // Called on skin cells by muscle cells. Will randomly fail, i.e. cuts.
-	WorkType_exchange  WorkType = 3 // Called on blood cells by other cells.
-	WorkType_exhale    WorkType = 4 // Called on lung cells by blood cells.
-	WorkType_pump      WorkType = 5 // Called on to heart cells to pump, by brain cels.
-	WorkType_move      WorkType = 6 // Called on muscle cells by brain cells.
-	WorkType_think     WorkType = 7 // Called on brain cells to perform a computation, by muscle cells.
-	WorkType_digest    WorkType = 8 // Called on gut cells, by muscle cells.
-	WorkType_filter    WorkType = 9 // Called on kidney cells, by blood cells.
+	WorkType_nothing        WorkType = 0
+	WorkType_diffusion      WorkType = 1
+	WorkType_cover          WorkType = 2  // Called on skin cells by muscle cells. Will randomly fail, i.e. cuts.
+	WorkType_exchange       WorkType = 3  // Called on blood cells by other cells.
+	WorkType_exhale         WorkType = 4  // Called on lung cells by blood cells.
+	WorkType_pump           WorkType = 5  // Called on to heart cells to pump, by brain cels.
+	WorkType_move           WorkType = 6  // Called on muscle cells by brain cells.
+	WorkType_think          WorkType = 7  // Called on brain cells to perform a computation, by muscle cells.
+	WorkType_digest         WorkType = 8  // Called on gut cells, by muscle cells.
+	WorkType_filter         WorkType = 9  // Called on kidney cells, by blood cells.
+	WorkType_glycogenesis   WorkType = 10 // Called on liver cells to store glucose, by blood cells.
+	WorkType_glycogenolysis WorkType = 11 // Called on liver cells to release stored glucose, by blood cells.
+	WorkType_detoxify       WorkType = 12 // Called on liver cells to metabolize ammonia, by blood cells.
+	WorkType_complement     WorkType = 13 // Called on liver cells to secrete complement proteins, by blood cells.
 )
 
 // Enum value maps for WorkType.
 var (
 	WorkType_name = map[int32]string{
-		0: "nothing",
-		1: "diffusion",
-		2: "cover",
-		3: "exchange",
-		4: "exhale",
-		5: "pump",
-		6: "move",
-		7: "think",
-		8: "digest",
-		9: "filter",
+		0:  "nothing",
+		1:  "diffusion",
+		2:  "cover",
+		3:  "exchange",
+		4:  "exhale",
+		5:  "pump",
+		6:  "move",
+		7:  "think",
+		8:  "digest",
+		9:  "filter",
+		10: "glycogenesis",
+		11: "glycogenolysis",
+		12: "detoxify",
+		13: "complement",
 	}
 	WorkType_value = map[string]int32{
-		"nothing":   0,
-		"diffusion": 1,
-		"cover":     2,
-		"exchange":  3,
-		"exhale":    4,
-		"pump":      5,
-		"move":      6,
-		"think":     7,
-		"digest":    8,
-		"filter":    9,
+		"nothing":        0,
+		"diffusion":      1,
+		"cover":          2,
+		"exchange":       3,
+		"exhale":         4,
+		"pump":           5,
+		"move":           6,
+		"think":          7,
+		"digest":         8,
+		"filter":         9,
+		"glycogenesis":   10,
+		"glycogenolysis": 11,
+		"detoxify":       12,
+		"complement":     13,
 	}
 )
 
@@ -214,6 +250,8 @@ const (
 	CytokineType_antigen_present   CytokineType = 3
 	CytokineType_induce_chemotaxis CytokineType = 4
 	CytokineType_cytotoxins        CytokineType = 5
+	CytokineType_anaphylatoxin     CytokineType = 6
+	CytokineType_anti_inflammatory CytokineType = 7
 )
 
 // Enum value maps for CytokineType.
@@ -225,6 +263,8 @@ var (
 		3: "antigen_present",
 		4: "induce_chemotaxis",
 		5: "cytotoxins",
+		6: "anaphylatoxin",
+		7: "anti_inflammatory",
 	}
 	CytokineType_value = map[string]int32{
 		"unknown":           0,
@@ -233,6 +273,8 @@ var (
 		"antigen_present":   3,
 		"induce_chemotaxis": 4,
 		"cytotoxins":        5,
+		"anaphylatoxin":     6,
+		"anti_inflammatory": 7,
 	}
 )
 
@@ -437,6 +479,58 @@ func (InteractionType) EnumDescriptor() ([]byte, []int) {
 	return file_efflux_proto_rawDescGZIP(), []int{5}
 }
 
+type AntibodyIsotype int32
+
+const (
+	AntibodyIsotype_IgM AntibodyIsotype = 0 // Made first, before any class switching.
+	AntibodyIsotype_IgG AntibodyIsotype = 1 // Neutralizes and opsonizes throughout the body.
+	AntibodyIsotype_IgA AntibodyIsotype = 2 // Secreted across the gut lining.
+	AntibodyIsotype_IgE AntibodyIsotype = 3 // Marks cells for killing, but doesn't neutralize.
+)
+
+// Enum value maps for AntibodyIsotype.
+var (
+	AntibodyIsotype_name = map[int32]string{
+		0: "IgM",
+		1: "IgG",
+		2: "IgA",
+		3: "IgE",
+	}
+	AntibodyIsotype_value = map[string]int32{
+		"IgM": 0,
+		"IgG": 1,
+		"IgA": 2,
+		"IgE": 3,
+	}
+)
+
+func (x AntibodyIsotype) Enum() *AntibodyIsotype {
+	p := new(AntibodyIsotype)
+	*p = x
+	return p
+}
+
+func (x AntibodyIsotype) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AntibodyIsotype) Descriptor() protoreflect.EnumDescriptor {
+	return file_efflux_proto_enumTypes[6].Descriptor()
+}
+
+func (AntibodyIsotype) Type() protoreflect.EnumType {
+	return &file_efflux_proto_enumTypes[6]
+}
+
+func (x AntibodyIsotype) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AntibodyIsotype.Descriptor instead.
+func (AntibodyIsotype) EnumDescriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{6}
+}
+
 type InteractionResponse_Status int32
 
 const (
@@ -467,11 +561,11 @@ func (x InteractionResponse_Status) String() string {
 }
 
 func (InteractionResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_efflux_proto_enumTypes[6].Descriptor()
+	return file_efflux_proto_enumTypes[7].Descriptor()
 }
 
 func (InteractionResponse_Status) Type() protoreflect.EnumType {
-	return &file_efflux_proto_enumTypes[6]
+	return &file_efflux_proto_enumTypes[7]
 }
 
 func (x InteractionResponse_Status) Number() protoreflect.EnumNumber {
@@ -624,6 +718,7 @@ type WasteBlobSocketData struct {
 
 	CO2        int32 `protobuf:"varint,1,opt,name=c_o2,json=cO2,proto3" json:"c_o2,omitempty"`
 	Creatinine int32 `protobuf:"varint,2,opt,name=creatinine,proto3" json:"creatinine,omitempty"`
+	Ammonia    int32 `protobuf:"varint,3,opt,name=ammonia,proto3" json:"ammonia,omitempty"`
 }
 
 func (x *WasteBlobSocketData) Reset() {
@@ -672,6 +767,13 @@ func (x *WasteBlobSocketData) GetCreatinine() int32 {
 	return 0
 }
 
+func (x *WasteBlobSocketData) GetAmmonia() int32 {
+	if x != nil {
+		return x.Ammonia
+	}
+	return 0
+}
+
 type HormoneBlobSocketData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -681,6 +783,10 @@ type HormoneBlobSocketData struct {
 	MacrophageColonyStimulatingFactor  int32 `protobuf:"varint,2,opt,name=macrophage_colony_stimulating_factor,json=macrophageColonyStimulatingFactor,proto3" json:"macrophage_colony_stimulating_factor,omitempty"`
 	Interleukin3                       int32 `protobuf:"varint,3,opt,name=interleukin3,proto3" json:"interleukin3,omitempty"`
 	Interleukin2                       int32 `protobuf:"varint,4,opt,name=interleukin2,proto3" json:"interleukin2,omitempty"`
+	Complement                         int32 `protobuf:"varint,5,opt,name=complement,proto3" json:"complement,omitempty"`
+	Insulin                            int32 `protobuf:"varint,6,opt,name=insulin,proto3" json:"insulin,omitempty"`
+	Glucagon                           int32 `protobuf:"varint,7,opt,name=glucagon,proto3" json:"glucagon,omitempty"`
+	Interleukin10                      int32 `protobuf:"varint,8,opt,name=interleukin10,proto3" json:"interleukin10,omitempty"`
 }
 
 func (x *HormoneBlobSocketData) Reset() {
@@ -743,13 +849,43 @@ func (x *HormoneBlobSocketData) GetInterleukin2() int32 {
 	return 0
 }
 
+func (x *HormoneBlobSocketData) GetComplement() int32 {
+	if x != nil {
+		return x.Complement
+	}
+	return 0
+}
+
+func (x *HormoneBlobSocketData) GetInsulin() int32 {
+	if x != nil {
+		return x.Insulin
+	}
+	return 0
+}
+
+func (x *HormoneBlobSocketData) GetGlucagon() int32 {
+	if x != nil {
+		return x.Glucagon
+	}
+	return 0
+}
+
+func (x *HormoneBlobSocketData) GetInterleukin10() int32 {
+	if x != nil {
+		return x.Interleukin10
+	}
+	return 0
+}
+
 type AntigenBlobSocketData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AntibodyProteins       []int32 `protobuf:"varint,1,rep,packed,name=antibody_proteins,json=antibodyProteins,proto3" json:"antibody_proteins,omitempty"`
-	AntibodyConcentrations []int64 `protobuf:"varint,2,rep,packed,name=antibody_concentrations,json=antibodyConcentrations,proto3" json:"antibody_concentrations,omitempty"`
+	AntibodyProteins       []int32           `protobuf:"varint,1,rep,packed,name=antibody_proteins,json=antibodyProteins,proto3" json:"antibody_proteins,omitempty"`
+	AntibodyConcentrations []int64           `protobuf:"varint,2,rep,packed,name=antibody_concentrations,json=antibodyConcentrations,proto3" json:"antibody_concentrations,omitempty"`
+	AntibodyIsotypes       []AntibodyIsotype `protobuf:"varint,3,rep,packed,name=antibody_isotypes,json=antibodyIsotypes,proto3,enum=efflux.AntibodyIsotype" json:"antibody_isotypes,omitempty"`
+	AntibodyAffinities     []int32           `protobuf:"varint,4,rep,packed,name=antibody_affinities,json=antibodyAffinities,proto3" json:"antibody_affinities,omitempty"`
 }
 
 func (x *AntigenBlobSocketData) Reset() {
@@ -798,6 +934,20 @@ func (x *AntigenBlobSocketData) GetAntibodyConcentrations() []int64 {
 	return nil
 }
 
+func (x *AntigenBlobSocketData) GetAntibodyIsotypes() []AntibodyIsotype {
+	if x != nil {
+		return x.AntibodyIsotypes
+	}
+	return nil
+}
+
+func (x *AntigenBlobSocketData) GetAntibodyAffinities() []int32 {
+	if x != nil {
+		return x.AntibodyAffinities
+	}
+	return nil
+}
+
 type DiffusionSocketData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -976,6 +1126,12 @@ type MaterialStatusSocketData struct {
 	Il_2         int32 `protobuf:"varint,13,opt,name=il_2,json=il2,proto3" json:"il_2,omitempty"`
 	ViralLoad    int32 `protobuf:"varint,14,opt,name=viral_load,json=viralLoad,proto3" json:"viral_load,omitempty"`
 	AntibodyLoad int32 `protobuf:"varint,15,opt,name=antibody_load,json=antibodyLoad,proto3" json:"antibody_load,omitempty"`
+	Ammonia      int32 `protobuf:"varint,16,opt,name=ammonia,proto3" json:"ammonia,omitempty"`
+	Glycogen     int32 `protobuf:"varint,17,opt,name=glycogen,proto3" json:"glycogen,omitempty"`
+	Complement   int32 `protobuf:"varint,18,opt,name=complement,proto3" json:"complement,omitempty"`
+	Insulin      int32 `protobuf:"varint,19,opt,name=insulin,proto3" json:"insulin,omitempty"`
+	Glucagon     int32 `protobuf:"varint,20,opt,name=glucagon,proto3" json:"glucagon,omitempty"`
+	Il_10        int32 `protobuf:"varint,21,opt,name=il_10,json=il10,proto3" json:"il_10,omitempty"`
 }
 
 func (x *MaterialStatusSocketData) Reset() {
@@ -1115,6 +1271,48 @@ func (x *MaterialStatusSocketData) GetAntibodyLoad() int32 {
 	return 0
 }
 
+func (x *MaterialStatusSocketData) GetAmmonia() int32 {
+	if x != nil {
+		return x.Ammonia
+	}
+	return 0
+}
+
+func (x *MaterialStatusSocketData) GetGlycogen() int32 {
+	if x != nil {
+		return x.Glycogen
+	}
+	return 0
+}
+
+func (x *MaterialStatusSocketData) GetComplement() int32 {
+	if x != nil {
+		return x.Complement
+	}
+	return 0
+}
+
+func (x *MaterialStatusSocketData) GetInsulin() int32 {
+	if x != nil {
+		return x.Insulin
+	}
+	return 0
+}
+
+func (x *MaterialStatusSocketData) GetGlucagon() int32 {
+	if x != nil {
+		return x.Glucagon
+	}
+	return 0
+}
+
+func (x *MaterialStatusSocketData) GetIl_10() int32 {
+	if x != nil {
+		return x.Il_10
+	}
+	return 0
+}
+
 type StatusSocketData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1834,423 +2032,1776 @@ func (x *InteractionResponse) GetAttachedCellStatus() *CellStatus {
 	return nil
 }
 
-var File_efflux_proto protoreflect.FileDescriptor
+type DNASnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_efflux_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x66, 0x66, 0x6c,
-	0x75, 0x78, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f,
-	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
-	0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x6c,
-	0x75, 0x63, 0x6f, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e,
-	0x73, 0x22, 0x48, 0x0a, 0x13, 0x57, 0x61, 0x73, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x11, 0x0a, 0x04, 0x63, 0x5f, 0x6f, 0x32,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x4f, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69, 0x6e, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x15,
-	0x48, 0x6f, 0x72, 0x6d, 0x6f, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x25, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x6f,
-	0x63, 0x79, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x5f, 0x73, 0x74, 0x69, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x6f, 0x63, 0x79, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x53, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x24, 0x6d, 0x61, 0x63, 0x72,
-	0x6f, 0x70, 0x68, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x5f, 0x73, 0x74,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x21, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x70, 0x68, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x53, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x33, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x32, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e,
-	0x32, 0x22, 0x7d, 0x0a, 0x15, 0x41, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x62,
-	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6e,
-	0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x50,
-	0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x61, 0x6e, 0x74, 0x69, 0x62,
-	0x6f, 0x64, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x16, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f,
-	0x64, 0x79, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xf8, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x66,
-	0x66, 0x6c, 0x75, 0x78, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f,
-	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x77, 0x61, 0x73, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x57,
-	0x61, 0x73, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x05, 0x77, 0x61, 0x73, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x68, 0x6f, 0x72,
-	0x6d, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x66, 0x66,
-	0x6c, 0x75, 0x78, 0x2e, 0x48, 0x6f, 0x72, 0x6d, 0x6f, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x68, 0x6f, 0x72, 0x6d, 0x6f,
-	0x6e, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74,
-	0x69, 0x67, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x07, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x22, 0x83, 0x02, 0x0a, 0x14,
-	0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x94, 0x03, 0x0a, 0x18, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x6f, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x32, 0x12, 0x18,
-	0x0a, 0x07, 0x67, 0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x67, 0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x74, 0x61,
-	0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x69, 0x74, 0x61, 0x6d,
-	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x63, 0x6f, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69,
-	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6e, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x75,
-	0x6e, 0x67, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x70, 0x68, 0x79, 0x78, 0x69, 0x61,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x73, 0x70, 0x68, 0x79, 0x78, 0x69, 0x61,
-	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x6d, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x67, 0x5f, 0x63, 0x73, 0x66, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x67, 0x43, 0x73, 0x66, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x5f, 0x63,
-	0x73, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x43, 0x73, 0x66, 0x12, 0x11,
-	0x0a, 0x04, 0x69, 0x6c, 0x5f, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x6c,
-	0x33, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x6c, 0x5f, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x69, 0x6c, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x4c,
-	0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6e, 0x74, 0x69,
-	0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x77,
-	0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a,
-	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x61,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x4d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78,
-	0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x65, 0x6c,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65,
-	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75,
-	0x78, 0x2e, 0x4e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c,
-	0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x52, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x34, 0x0a,
-	0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x01, 0x7a, 0x22, 0x9b, 0x03, 0x0a, 0x0a, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x2d, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c,
-	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x69, 0x72,
-	0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a,
-	0x09, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x77, 0x61, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x66, 0x66,
-	0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x3e, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x74, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x12,
-	0x39, 0x0a, 0x0d, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e,
-	0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x79,
-	0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf0, 0x02, 0x0a, 0x13, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x22, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54,
-	0x6f, 0x12, 0x40, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x14, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x43,
-	0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x01, 0x2a, 0xca, 0x03,
-	0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x65,
-	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x0c, 0x0a, 0x08, 0x42, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x61, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x42, 0x61, 0x63, 0x74, 0x65, 0x72, 0x6f, 0x69, 0x64, 0x6f, 0x74, 0x61, 0x10, 0x02, 0x12,
-	0x0c, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x6f, 0x64, 0x10, 0x03, 0x12, 0x0a, 0x0a,
-	0x06, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x61, 0x72,
-	0x64, 0x69, 0x6f, 0x6d, 0x79, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a,
-	0x50, 0x6e, 0x65, 0x75, 0x6d, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07,
-	0x4d, 0x79, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6e, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
-	0x6e, 0x74, 0x65, 0x72, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x50,
-	0x6f, 0x64, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x65, 0x6d,
-	0x6f, 0x63, 0x79, 0x74, 0x6f, 0x62, 0x6c, 0x61, 0x73, 0x74, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b,
-	0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x62, 0x6c, 0x61, 0x73, 0x74, 0x10, 0x0c, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4d, 0x79, 0x65, 0x6c, 0x6f, 0x62, 0x6c, 0x61, 0x73, 0x74, 0x10, 0x0d, 0x12, 0x0c, 0x0a,
-	0x08, 0x4d, 0x6f, 0x6e, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
-	0x61, 0x63, 0x72, 0x6f, 0x70, 0x68, 0x61, 0x67, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x0f, 0x12,
-	0x0d, 0x0a, 0x09, 0x44, 0x65, 0x6e, 0x64, 0x72, 0x69, 0x74, 0x69, 0x63, 0x10, 0x10, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x65, 0x75, 0x74, 0x72, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x11, 0x12, 0x15,
-	0x0a, 0x11, 0x4e, 0x61, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x43,
-	0x65, 0x6c, 0x6c, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x69, 0x72, 0x67, 0x69, 0x6e, 0x54,
-	0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x13, 0x12, 0x15, 0x0a, 0x11,
-	0x48, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x54, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74,
-	0x65, 0x10, 0x14, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x4c, 0x79,
-	0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x15, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c,
-	0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x16, 0x12, 0x17, 0x0a, 0x13, 0x45,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79,
-	0x74, 0x65, 0x10, 0x17, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61,
-	0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x10, 0x18, 0x2a, 0x82, 0x01, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x68, 0x69,
-	0x6e, 0x67, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f,
-	0x6e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
-	0x65, 0x78, 0x68, 0x61, 0x6c, 0x65, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x75, 0x6d, 0x70,
-	0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
-	0x74, 0x68, 0x69, 0x6e, 0x6b, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x10, 0x09, 0x2a,
-	0x7b, 0x0a, 0x0c, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
-	0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a,
-	0x0d, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x10, 0x02,
-	0x12, 0x13, 0x0a, 0x0f, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x69, 0x6e, 0x64, 0x75, 0x63, 0x65, 0x5f,
-	0x63, 0x68, 0x65, 0x6d, 0x6f, 0x74, 0x61, 0x78, 0x69, 0x73, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a,
-	0x63, 0x79, 0x74, 0x6f, 0x74, 0x6f, 0x78, 0x69, 0x6e, 0x73, 0x10, 0x05, 0x2a, 0x2e, 0x0a, 0x0b,
-	0x4e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e,
-	0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x4e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x10, 0x01, 0x2a, 0x85, 0x01, 0x0a,
-	0x10, 0x43, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x69, 0x6e, 0x63, 0x75, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12,
-	0x0b, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
-	0x61, 0x70, 0x6f, 0x70, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x64,
-	0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x69, 0x74, 0x6f, 0x73,
-	0x69, 0x73, 0x10, 0x07, 0x2a, 0x74, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10,
-	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x10, 0x05, 0x12, 0x08, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f,
-	0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x10, 0x07, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f,
-	0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DnaType int32  `protobuf:"varint,2,opt,name=dna_type,json=dnaType,proto3" json:"dna_type,omitempty"`
+	Base    []byte `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Id      string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // Registry ID. Alone, it refers to DNA the receiver already has.
 }
 
-var (
-	file_efflux_proto_rawDescOnce sync.Once
-	file_efflux_proto_rawDescData = file_efflux_proto_rawDesc
-)
+func (x *DNASnapshot) Reset() {
+	*x = DNASnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_efflux_proto_rawDescGZIP() []byte {
-	file_efflux_proto_rawDescOnce.Do(func() {
-		file_efflux_proto_rawDescData = protoimpl.X.CompressGZIP(file_efflux_proto_rawDescData)
-	})
-	return file_efflux_proto_rawDescData
+func (x *DNASnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_efflux_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_efflux_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_efflux_proto_goTypes = []interface{}{
-	(CellType)(0),                    // 0: efflux.CellType
-	(WorkType)(0),                    // 1: efflux.WorkType
-	(CytokineType)(0),                // 2: efflux.CytokineType
-	(NanobotType)(0),                 // 3: efflux.NanobotType
-	(CellActionStatus)(0),            // 4: efflux.CellActionStatus
-	(InteractionType)(0),             // 5: efflux.InteractionType
-	(InteractionResponse_Status)(0),  // 6: efflux.InteractionResponse.Status
-	(*WorkSocketData)(nil),           // 7: efflux.WorkSocketData
-	(*ResourceBlobSocketData)(nil),   // 8: efflux.ResourceBlobSocketData
-	(*WasteBlobSocketData)(nil),      // 9: efflux.WasteBlobSocketData
-	(*HormoneBlobSocketData)(nil),    // 10: efflux.HormoneBlobSocketData
-	(*AntigenBlobSocketData)(nil),    // 11: efflux.AntigenBlobSocketData
-	(*DiffusionSocketData)(nil),      // 12: efflux.DiffusionSocketData
-	(*WorkStatusSocketData)(nil),     // 13: efflux.WorkStatusSocketData
-	(*MaterialStatusSocketData)(nil), // 14: efflux.MaterialStatusSocketData
-	(*StatusSocketData)(nil),         // 15: efflux.StatusSocketData
-	(*RenderType)(nil),               // 16: efflux.RenderType
-	(*RenderableSocketData)(nil),     // 17: efflux.RenderableSocketData
-	(*Position)(nil),                 // 18: efflux.Position
-	(*CellStatus)(nil),               // 19: efflux.CellStatus
-	(*InteractionLoginRequest)(nil),  // 20: efflux.InteractionLoginRequest
-	(*InteractionLoginResponse)(nil), // 21: efflux.InteractionLoginResponse
-	(*InteractionRequest)(nil),       // 22: efflux.InteractionRequest
-	(*InteractionResponse)(nil),      // 23: efflux.InteractionResponse
+func (*DNASnapshot) ProtoMessage() {}
+
+func (x *DNASnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_efflux_proto_depIdxs = []int32{
-	12, // 0: efflux.WorkSocketData.diffusion:type_name -> efflux.DiffusionSocketData
-	8,  // 1: efflux.DiffusionSocketData.resources:type_name -> efflux.ResourceBlobSocketData
-	9,  // 2: efflux.DiffusionSocketData.waste:type_name -> efflux.WasteBlobSocketData
-	10, // 3: efflux.DiffusionSocketData.hormone:type_name -> efflux.HormoneBlobSocketData
-	11, // 4: efflux.DiffusionSocketData.antigen:type_name -> efflux.AntigenBlobSocketData
-	13, // 5: efflux.StatusSocketData.work_status:type_name -> efflux.WorkStatusSocketData
-	14, // 6: efflux.StatusSocketData.material_status:type_name -> efflux.MaterialStatusSocketData
-	0,  // 7: efflux.RenderType.cell_type:type_name -> efflux.CellType
-	2,  // 8: efflux.RenderType.cytokine_type:type_name -> efflux.CytokineType
-	3,  // 9: efflux.RenderType.nanobot_type:type_name -> efflux.NanobotType
-	18, // 10: efflux.RenderableSocketData.position:type_name -> efflux.Position
-	16, // 11: efflux.RenderableSocketData.type:type_name -> efflux.RenderType
-	0,  // 12: efflux.CellStatus.cell_type:type_name -> efflux.CellType
-	4,  // 13: efflux.CellStatus.cell_actions:type_name -> efflux.CellActionStatus
-	5,  // 14: efflux.InteractionRequest.type:type_name -> efflux.InteractionType
-	18, // 15: efflux.InteractionRequest.position:type_name -> efflux.Position
-	2,  // 16: efflux.InteractionRequest.cytokine_type:type_name -> efflux.CytokineType
-	5,  // 17: efflux.InteractionResponse.type:type_name -> efflux.InteractionType
-	6,  // 18: efflux.InteractionResponse.status:type_name -> efflux.InteractionResponse.Status
-	19, // 19: efflux.InteractionResponse.target_cell_status:type_name -> efflux.CellStatus
-	19, // 20: efflux.InteractionResponse.attached_cell_status:type_name -> efflux.CellStatus
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+
+// Deprecated: Use DNASnapshot.ProtoReflect.Descriptor instead.
+func (*DNASnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_efflux_proto_init() }
-func file_efflux_proto_init() {
-	if File_efflux_proto != nil {
-		return
+func (x *DNASnapshot) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_efflux_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkSocketData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_efflux_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceBlobSocketData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_efflux_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WasteBlobSocketData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_efflux_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HormoneBlobSocketData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *DNASnapshot) GetDnaType() int32 {
+	if x != nil {
+		return x.DnaType
+	}
+	return 0
+}
+
+func (x *DNASnapshot) GetBase() []byte {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DNASnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ViralLoadSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dna            int32    `protobuf:"varint,1,opt,name=dna,proto3" json:"dna,omitempty"` // Index into Snapshot.dna.
+	TargetCellType CellType `protobuf:"varint,2,opt,name=target_cell_type,json=targetCellType,proto3,enum=efflux.CellType" json:"target_cell_type,omitempty"`
+	Infectivity    int64    `protobuf:"varint,3,opt,name=infectivity,proto3" json:"infectivity,omitempty"`
+	Concentration  int64    `protobuf:"varint,4,opt,name=concentration,proto3" json:"concentration,omitempty"`
+}
+
+func (x *ViralLoadSnapshot) Reset() {
+	*x = ViralLoadSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViralLoadSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViralLoadSnapshot) ProtoMessage() {}
+
+func (x *ViralLoadSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_efflux_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AntigenBlobSocketData); i {
-			case 0:
-				return &v.state
-			case 1:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViralLoadSnapshot.ProtoReflect.Descriptor instead.
+func (*ViralLoadSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ViralLoadSnapshot) GetDna() int32 {
+	if x != nil {
+		return x.Dna
+	}
+	return 0
+}
+
+func (x *ViralLoadSnapshot) GetTargetCellType() CellType {
+	if x != nil {
+		return x.TargetCellType
+	}
+	return CellType_CellTypeUnknown
+}
+
+func (x *ViralLoadSnapshot) GetInfectivity() int64 {
+	if x != nil {
+		return x.Infectivity
+	}
+	return 0
+}
+
+func (x *ViralLoadSnapshot) GetConcentration() int64 {
+	if x != nil {
+		return x.Concentration
+	}
+	return 0
+}
+
+type AntibodyLoadSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetProtein uint32          `protobuf:"varint,1,opt,name=target_protein,json=targetProtein,proto3" json:"target_protein,omitempty"`
+	Concentration int64           `protobuf:"varint,2,opt,name=concentration,proto3" json:"concentration,omitempty"`
+	Isotype       AntibodyIsotype `protobuf:"varint,3,opt,name=isotype,proto3,enum=efflux.AntibodyIsotype" json:"isotype,omitempty"`
+	Affinity      int32           `protobuf:"varint,4,opt,name=affinity,proto3" json:"affinity,omitempty"`
+}
+
+func (x *AntibodyLoadSnapshot) Reset() {
+	*x = AntibodyLoadSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AntibodyLoadSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AntibodyLoadSnapshot) ProtoMessage() {}
+
+func (x *AntibodyLoadSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AntibodyLoadSnapshot.ProtoReflect.Descriptor instead.
+func (*AntibodyLoadSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AntibodyLoadSnapshot) GetTargetProtein() uint32 {
+	if x != nil {
+		return x.TargetProtein
+	}
+	return 0
+}
+
+func (x *AntibodyLoadSnapshot) GetConcentration() int64 {
+	if x != nil {
+		return x.Concentration
+	}
+	return 0
+}
+
+func (x *AntibodyLoadSnapshot) GetIsotype() AntibodyIsotype {
+	if x != nil {
+		return x.Isotype
+	}
+	return AntibodyIsotype_IgM
+}
+
+func (x *AntibodyLoadSnapshot) GetAffinity() int32 {
+	if x != nil {
+		return x.Affinity
+	}
+	return 0
+}
+
+type CytokineSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level         int32        `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Position      *Position    `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	CytokineType  CytokineType `protobuf:"varint,3,opt,name=cytokine_type,json=cytokineType,proto3,enum=efflux.CytokineType" json:"cytokine_type,omitempty"`
+	Concentration uint32       `protobuf:"varint,4,opt,name=concentration,proto3" json:"concentration,omitempty"`
+	Radius        int32        `protobuf:"varint,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	LastTick      int64        `protobuf:"varint,6,opt,name=last_tick,json=lastTick,proto3" json:"last_tick,omitempty"`
+}
+
+func (x *CytokineSnapshot) Reset() {
+	*x = CytokineSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CytokineSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CytokineSnapshot) ProtoMessage() {}
+
+func (x *CytokineSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CytokineSnapshot.ProtoReflect.Descriptor instead.
+func (*CytokineSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CytokineSnapshot) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CytokineSnapshot) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *CytokineSnapshot) GetCytokineType() CytokineType {
+	if x != nil {
+		return x.CytokineType
+	}
+	return CytokineType_unknown
+}
+
+func (x *CytokineSnapshot) GetConcentration() uint32 {
+	if x != nil {
+		return x.Concentration
+	}
+	return 0
+}
+
+func (x *CytokineSnapshot) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *CytokineSnapshot) GetLastTick() int64 {
+	if x != nil {
+		return x.LastTick
+	}
+	return 0
+}
+
+type CellSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellType           CellType                `protobuf:"varint,1,opt,name=cell_type,json=cellType,proto3,enum=efflux.CellType" json:"cell_type,omitempty"`
+	WorkType           WorkType                `protobuf:"varint,2,opt,name=work_type,json=workType,proto3,enum=efflux.WorkType" json:"work_type,omitempty"`
+	Dna                int32                   `protobuf:"varint,3,opt,name=dna,proto3" json:"dna,omitempty"` // Index into Snapshot.dna.
+	RenderId           string                  `protobuf:"bytes,4,opt,name=render_id,json=renderId,proto3" json:"render_id,omitempty"`
+	Position           *Position               `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	Target             *Position               `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	FollowId           string                  `protobuf:"bytes,7,opt,name=follow_id,json=followId,proto3" json:"follow_id,omitempty"`
+	Damage             int32                   `protobuf:"varint,8,opt,name=damage,proto3" json:"damage,omitempty"`
+	Oxygenated         bool                    `protobuf:"varint,9,opt,name=oxygenated,proto3" json:"oxygenated,omitempty"`
+	SpawnTime          int64                   `protobuf:"varint,10,opt,name=spawn_time,json=spawnTime,proto3" json:"spawn_time,omitempty"`
+	TransportTime      int64                   `protobuf:"varint,11,opt,name=transport_time,json=transportTime,proto3" json:"transport_time,omitempty"`
+	TransportPath      []string                `protobuf:"bytes,12,rep,name=transport_path,json=transportPath,proto3" json:"transport_path,omitempty"` // Node names.
+	WantPath           []string                `protobuf:"bytes,13,rep,name=want_path,json=wantPath,proto3" json:"want_path,omitempty"`                // Node names.
+	Proteins           []uint32                `protobuf:"varint,14,rep,packed,name=proteins,proto3" json:"proteins,omitempty"`
+	Presented          []uint32                `protobuf:"varint,15,rep,packed,name=presented,proto3" json:"presented,omitempty"`
+	ViralLoad          *ViralLoadSnapshot      `protobuf:"bytes,16,opt,name=viral_load,json=viralLoad,proto3" json:"viral_load,omitempty"`
+	AntibodyLoad       *AntibodyLoadSnapshot   `protobuf:"bytes,17,opt,name=antibody_load,json=antibodyLoad,proto3" json:"antibody_load,omitempty"`
+	State              int32                   `protobuf:"varint,18,opt,name=state,proto3" json:"state,omitempty"`                                         // Position of the state diagram cursor from its root.
+	ActivationTime     int64                   `protobuf:"varint,19,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"` // Macrophages and memory cells.
+	InNetosis          bool                    `protobuf:"varint,20,opt,name=in_netosis,json=inNetosis,proto3" json:"in_netosis,omitempty"`                // Neutrophils.
+	Energy             int32                   `protobuf:"varint,21,opt,name=energy,proto3" json:"energy,omitempty"`                                       // Bacteria.
+	LastGenerationTime int64                   `protobuf:"varint,22,opt,name=last_generation_time,json=lastGenerationTime,proto3" json:"last_generation_time,omitempty"`
+	LineageId          string                  `protobuf:"bytes,23,opt,name=lineage_id,json=lineageId,proto3" json:"lineage_id,omitempty"`
+	ParentLineageId    string                  `protobuf:"bytes,24,opt,name=parent_lineage_id,json=parentLineageId,proto3" json:"parent_lineage_id,omitempty"`
+	CellActions        []CellActionStatus      `protobuf:"varint,25,rep,packed,name=cell_actions,json=cellActions,proto3,enum=efflux.CellActionStatus" json:"cell_actions,omitempty"` // Oldest first.
+	ResourceNeed       *ResourceBlobSocketData `protobuf:"bytes,26,opt,name=resource_need,json=resourceNeed,proto3" json:"resource_need,omitempty"`                                   // What the cell has yet to collect.
+	Opsonins           int32                   `protobuf:"varint,27,opt,name=opsonins,proto3" json:"opsonins,omitempty"`
+	Isotype            AntibodyIsotype         `protobuf:"varint,28,opt,name=isotype,proto3,enum=efflux.AntibodyIsotype" json:"isotype,omitempty"` // B cells, the antibody they make.
+	Affinity           int32                   `protobuf:"varint,29,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	MutationTime       int64                   `protobuf:"varint,30,opt,name=mutation_time,json=mutationTime,proto3" json:"mutation_time,omitempty"`
+	MemoryCells        int32                   `protobuf:"varint,31,opt,name=memory_cells,json=memoryCells,proto3" json:"memory_cells,omitempty"` // Virgin T and B cells, the memory cells they left behind.
+}
+
+func (x *CellSnapshot) Reset() {
+	*x = CellSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellSnapshot) ProtoMessage() {}
+
+func (x *CellSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellSnapshot.ProtoReflect.Descriptor instead.
+func (*CellSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CellSnapshot) GetCellType() CellType {
+	if x != nil {
+		return x.CellType
+	}
+	return CellType_CellTypeUnknown
+}
+
+func (x *CellSnapshot) GetWorkType() WorkType {
+	if x != nil {
+		return x.WorkType
+	}
+	return WorkType_nothing
+}
+
+func (x *CellSnapshot) GetDna() int32 {
+	if x != nil {
+		return x.Dna
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetRenderId() string {
+	if x != nil {
+		return x.RenderId
+	}
+	return ""
+}
+
+func (x *CellSnapshot) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetTarget() *Position {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetFollowId() string {
+	if x != nil {
+		return x.FollowId
+	}
+	return ""
+}
+
+func (x *CellSnapshot) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetOxygenated() bool {
+	if x != nil {
+		return x.Oxygenated
+	}
+	return false
+}
+
+func (x *CellSnapshot) GetSpawnTime() int64 {
+	if x != nil {
+		return x.SpawnTime
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetTransportTime() int64 {
+	if x != nil {
+		return x.TransportTime
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetTransportPath() []string {
+	if x != nil {
+		return x.TransportPath
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetWantPath() []string {
+	if x != nil {
+		return x.WantPath
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetProteins() []uint32 {
+	if x != nil {
+		return x.Proteins
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetPresented() []uint32 {
+	if x != nil {
+		return x.Presented
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetViralLoad() *ViralLoadSnapshot {
+	if x != nil {
+		return x.ViralLoad
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetAntibodyLoad() *AntibodyLoadSnapshot {
+	if x != nil {
+		return x.AntibodyLoad
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetActivationTime() int64 {
+	if x != nil {
+		return x.ActivationTime
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetInNetosis() bool {
+	if x != nil {
+		return x.InNetosis
+	}
+	return false
+}
+
+func (x *CellSnapshot) GetEnergy() int32 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetLastGenerationTime() int64 {
+	if x != nil {
+		return x.LastGenerationTime
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetLineageId() string {
+	if x != nil {
+		return x.LineageId
+	}
+	return ""
+}
+
+func (x *CellSnapshot) GetParentLineageId() string {
+	if x != nil {
+		return x.ParentLineageId
+	}
+	return ""
+}
+
+func (x *CellSnapshot) GetCellActions() []CellActionStatus {
+	if x != nil {
+		return x.CellActions
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetResourceNeed() *ResourceBlobSocketData {
+	if x != nil {
+		return x.ResourceNeed
+	}
+	return nil
+}
+
+func (x *CellSnapshot) GetOpsonins() int32 {
+	if x != nil {
+		return x.Opsonins
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetIsotype() AntibodyIsotype {
+	if x != nil {
+		return x.Isotype
+	}
+	return AntibodyIsotype_IgM
+}
+
+func (x *CellSnapshot) GetAffinity() int32 {
+	if x != nil {
+		return x.Affinity
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetMutationTime() int64 {
+	if x != nil {
+		return x.MutationTime
+	}
+	return 0
+}
+
+func (x *CellSnapshot) GetMemoryCells() int32 {
+	if x != nil {
+		return x.MemoryCells
+	}
+	return 0
+}
+
+type NodeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Organ         string                    `protobuf:"bytes,2,opt,name=organ,proto3" json:"organ,omitempty"`
+	Verbose       bool                      `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Materials     *MaterialStatusSocketData `protobuf:"bytes,4,opt,name=materials,proto3" json:"materials,omitempty"`
+	ViralLoads    []*ViralLoadSnapshot      `protobuf:"bytes,5,rep,name=viral_loads,json=viralLoads,proto3" json:"viral_loads,omitempty"`
+	AntibodyLoads []*AntibodyLoadSnapshot   `protobuf:"bytes,6,rep,name=antibody_loads,json=antibodyLoads,proto3" json:"antibody_loads,omitempty"`
+	Cytokines     []*CytokineSnapshot       `protobuf:"bytes,7,rep,name=cytokines,proto3" json:"cytokines,omitempty"`
+	Cells         []*CellSnapshot           `protobuf:"bytes,8,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *NodeSnapshot) Reset() {
+	*x = NodeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeSnapshot) ProtoMessage() {}
+
+func (x *NodeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeSnapshot.ProtoReflect.Descriptor instead.
+func (*NodeSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NodeSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeSnapshot) GetOrgan() string {
+	if x != nil {
+		return x.Organ
+	}
+	return ""
+}
+
+func (x *NodeSnapshot) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *NodeSnapshot) GetMaterials() *MaterialStatusSocketData {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *NodeSnapshot) GetViralLoads() []*ViralLoadSnapshot {
+	if x != nil {
+		return x.ViralLoads
+	}
+	return nil
+}
+
+func (x *NodeSnapshot) GetAntibodyLoads() []*AntibodyLoadSnapshot {
+	if x != nil {
+		return x.AntibodyLoads
+	}
+	return nil
+}
+
+func (x *NodeSnapshot) GetCytokines() []*CytokineSnapshot {
+	if x != nil {
+		return x.Cytokines
+	}
+	return nil
+}
+
+func (x *NodeSnapshot) GetCells() []*CellSnapshot {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type EdgeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	EdgeType int32  `protobuf:"varint,3,opt,name=edge_type,json=edgeType,proto3" json:"edge_type,omitempty"`
+}
+
+func (x *EdgeSnapshot) Reset() {
+	*x = EdgeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeSnapshot) ProtoMessage() {}
+
+func (x *EdgeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeSnapshot.ProtoReflect.Descriptor instead.
+func (*EdgeSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EdgeSnapshot) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EdgeSnapshot) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *EdgeSnapshot) GetEdgeType() int32 {
+	if x != nil {
+		return x.EdgeType
+	}
+	return 0
+}
+
+type LineageSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parent   string   `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	CellType CellType `protobuf:"varint,3,opt,name=cell_type,json=cellType,proto3,enum=efflux.CellType" json:"cell_type,omitempty"`
+	Born     int64    `protobuf:"varint,4,opt,name=born,proto3" json:"born,omitempty"`
+	BornIn   string   `protobuf:"bytes,5,opt,name=born_in,json=bornIn,proto3" json:"born_in,omitempty"`
+	Node     string   `protobuf:"bytes,6,opt,name=node,proto3" json:"node,omitempty"`
+	RenderId string   `protobuf:"bytes,7,opt,name=render_id,json=renderId,proto3" json:"render_id,omitempty"`
+	Died     int64    `protobuf:"varint,8,opt,name=died,proto3" json:"died,omitempty"` // Zero while the cell is alive.
+	Cause    string   `protobuf:"bytes,9,opt,name=cause,proto3" json:"cause,omitempty"`
+	Kills    int32    `protobuf:"varint,10,opt,name=kills,proto3" json:"kills,omitempty"`
+	KilledBy string   `protobuf:"bytes,11,opt,name=killed_by,json=killedBy,proto3" json:"killed_by,omitempty"`
+	Infected string   `protobuf:"bytes,12,opt,name=infected,proto3" json:"infected,omitempty"`
+	Children []string `protobuf:"bytes,13,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *LineageSnapshot) Reset() {
+	*x = LineageSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineageSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineageSnapshot) ProtoMessage() {}
+
+func (x *LineageSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineageSnapshot.ProtoReflect.Descriptor instead.
+func (*LineageSnapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LineageSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetCellType() CellType {
+	if x != nil {
+		return x.CellType
+	}
+	return CellType_CellTypeUnknown
+}
+
+func (x *LineageSnapshot) GetBorn() int64 {
+	if x != nil {
+		return x.Born
+	}
+	return 0
+}
+
+func (x *LineageSnapshot) GetBornIn() string {
+	if x != nil {
+		return x.BornIn
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetRenderId() string {
+	if x != nil {
+		return x.RenderId
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetDied() int64 {
+	if x != nil {
+		return x.Died
+	}
+	return 0
+}
+
+func (x *LineageSnapshot) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *LineageSnapshot) GetKilledBy() string {
+	if x != nil {
+		return x.KilledBy
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetInfected() string {
+	if x != nil {
+		return x.Infected
+	}
+	return ""
+}
+
+func (x *LineageSnapshot) GetChildren() []string {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// TransportRequest carries a cell to another node. Its DNA indexes refer to
+// dna, and its paths are transport URLs rather than node names.
+type TransportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cell           *CellSnapshot  `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Dna            []*DNASnapshot `protobuf:"bytes,2,rep,name=dna,proto3" json:"dna,omitempty"`
+	ParentRenderId string         `protobuf:"bytes,3,opt,name=parent_render_id,json=parentRenderId,proto3" json:"parent_render_id,omitempty"` // Where to place the cell, if the parent is in the same node.
+	Seed           int64          `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`                                            // Seeds the new cell's random stream, drawn by whoever sent it.
+}
+
+func (x *TransportRequest) Reset() {
+	*x = TransportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransportRequest) ProtoMessage() {}
+
+func (x *TransportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransportRequest.ProtoReflect.Descriptor instead.
+func (*TransportRequest) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransportRequest) GetCell() *CellSnapshot {
+	if x != nil {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *TransportRequest) GetDna() []*DNASnapshot {
+	if x != nil {
+		return x.Dna
+	}
+	return nil
+}
+
+func (x *TransportRequest) GetParentRenderId() string {
+	if x != nil {
+		return x.ParentRenderId
+	}
+	return ""
+}
+
+func (x *TransportRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed    int64              `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Time    int64              `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // Simulation clock, in Unix nanoseconds.
+	Paused  bool               `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	Speed   float64            `protobuf:"fixed64,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	Dna     []*DNASnapshot     `protobuf:"bytes,5,rep,name=dna,proto3" json:"dna,omitempty"`
+	Nodes   []*NodeSnapshot    `protobuf:"bytes,6,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges   []*EdgeSnapshot    `protobuf:"bytes,7,rep,name=edges,proto3" json:"edges,omitempty"`
+	Lineage []*LineageSnapshot `protobuf:"bytes,8,rep,name=lineage,proto3" json:"lineage,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_efflux_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_efflux_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_efflux_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Snapshot) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Snapshot) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Snapshot) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Snapshot) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Snapshot) GetDna() []*DNASnapshot {
+	if x != nil {
+		return x.Dna
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNodes() []*NodeSnapshot {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Snapshot) GetEdges() []*EdgeSnapshot {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *Snapshot) GetLineage() []*LineageSnapshot {
+	if x != nil {
+		return x.Lineage
+	}
+	return nil
+}
+
+var File_efflux_proto protoreflect.FileDescriptor
+
+var file_efflux_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x66, 0x66, 0x6c,
+	0x75, 0x78, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f,
+	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x6c,
+	0x75, 0x63, 0x6f, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e,
+	0x73, 0x22, 0x62, 0x0a, 0x13, 0x57, 0x61, 0x73, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x11, 0x0a, 0x04, 0x63, 0x5f, 0x6f, 0x32,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x4f, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x22, 0xff, 0x02, 0x0a, 0x15, 0x48, 0x6f, 0x72, 0x6d, 0x6f, 0x6e,
+	0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x51, 0x0a, 0x25, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x5f, 0x73, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x22,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x6e,
+	0x79, 0x53, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x24, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x70, 0x68, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x79, 0x5f, 0x73, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x21, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x70, 0x68, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
+	0x6e, 0x79, 0x53, 0x74, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b,
+	0x69, 0x6e, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x33, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x6e, 0x73, 0x75, 0x6c, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e,
+	0x73, 0x75, 0x6c, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x6c, 0x75, 0x63, 0x61, 0x67, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x6c, 0x75, 0x63, 0x61, 0x67, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x75, 0x6b, 0x69, 0x6e,
+	0x31, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c,
+	0x65, 0x75, 0x6b, 0x69, 0x6e, 0x31, 0x30, 0x22, 0xf4, 0x01, 0x0a, 0x15, 0x41, 0x6e, 0x74, 0x69,
+	0x67, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x61, 0x6e,
+	0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x12, 0x37,
+	0x0a, 0x17, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x16, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x61, 0x6e, 0x74, 0x69, 0x62,
+	0x6f, 0x64, 0x79, 0x5f, 0x69, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69,
+	0x62, 0x6f, 0x64, 0x79, 0x49, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x10, 0x61, 0x6e, 0x74,
+	0x69, 0x62, 0x6f, 0x64, 0x79, 0x49, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x12, 0x61, 0x6e, 0x74, 0x69,
+	0x62, 0x6f, 0x64, 0x79, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xf8,
+	0x01, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x66, 0x66, 0x6c,
+	0x75, 0x78, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x77, 0x61, 0x73, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x57, 0x61, 0x73,
+	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x77, 0x61, 0x73, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x68, 0x6f, 0x72, 0x6d, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75,
+	0x78, 0x2e, 0x48, 0x6f, 0x72, 0x6d, 0x6f, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x68, 0x6f, 0x72, 0x6d, 0x6f, 0x6e, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69, 0x67,
+	0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x07, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x22, 0x83, 0x02, 0x0a, 0x14, 0x57, 0x6f,
+	0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xb5, 0x04, 0x0a, 0x18, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x32, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67,
+	0x6c, 0x75, 0x63, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63,
+	0x6f, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x75,
+	0x6e, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x67,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x70, 0x68, 0x79, 0x78, 0x69, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x73, 0x70, 0x68, 0x79, 0x78, 0x69, 0x61, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x67, 0x5f, 0x63, 0x73, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x67, 0x43, 0x73, 0x66, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x5f, 0x63, 0x73, 0x66,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x43, 0x73, 0x66, 0x12, 0x11, 0x0a, 0x04,
+	0x69, 0x6c, 0x5f, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x6c, 0x33, 0x12,
+	0x11, 0x0a, 0x04, 0x69, 0x6c, 0x5f, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x6c, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f,
+	0x64, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6d, 0x6f, 0x6e, 0x69,
+	0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x6d, 0x6d, 0x6f, 0x6e, 0x69, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x67, 0x6c, 0x79, 0x63, 0x6f, 0x67, 0x65, 0x6e, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x67, 0x6c, 0x79, 0x63, 0x6f, 0x67, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6e, 0x73, 0x75, 0x6c, 0x69, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69,
+	0x6e, 0x73, 0x75, 0x6c, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x6c, 0x75, 0x63, 0x61, 0x67,
+	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x6c, 0x75, 0x63, 0x61, 0x67,
+	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x6c, 0x5f, 0x31, 0x30, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x69, 0x6c, 0x31, 0x30, 0x22, 0xea, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x66,
+	0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78,
+	0x2e, 0x4e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75,
+	0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x34, 0x0a, 0x08,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x7a, 0x22, 0x9b, 0x03, 0x0a, 0x0a, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2d, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x69, 0x72, 0x61,
+	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x61, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x66, 0x66, 0x6c,
+	0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x3e, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x74, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x39,
+	0x0a, 0x0d, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43,
+	0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x79, 0x74,
+	0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf0, 0x02, 0x0a, 0x13, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x6f,
+	0x12, 0x40, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65,
+	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x44, 0x0a, 0x14, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x43, 0x65,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x01, 0x22, 0x60, 0x0a, 0x0b,
+	0x44, 0x4e, 0x41, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x6e, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x64, 0x6e, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa9,
+	0x01, 0x0a, 0x11, 0x56, 0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x64, 0x6e, 0x61, 0x12, 0x3a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x41,
+	0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x07, 0x69, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69, 0x62,
+	0x6f, 0x64, 0x79, 0x49, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x22,
+	0xec, 0x01, 0x0a, 0x10, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65,
+	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x79, 0x74, 0x6f,
+	0x6b, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x22, 0xb1,
+	0x09, 0x0a, 0x0c, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x2d, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x6e, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x6e, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x66, 0x66,
+	0x6c, 0x75, 0x78, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x78, 0x79,
+	0x67, 0x65, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f,
+	0x78, 0x79, 0x67, 0x65, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x56, 0x69, 0x72, 0x61, 0x6c,
+	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x76, 0x69,
+	0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x6e, 0x74, 0x69, 0x62,
+	0x6f, 0x64, 0x79, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79,
+	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0c, 0x61, 0x6e,
+	0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f,
+	0x6e, 0x65, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x6e, 0x4e, 0x65, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a,
+	0x0c, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x19, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x63,
+	0x65, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6f, 0x70, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x69,
+	0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
+	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x49, 0x73,
+	0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x65, 0x6c,
+	0x6c, 0x73, 0x22, 0xf7, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x66, 0x66,
+	0x6c, 0x75, 0x78, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x76, 0x69, 0x72, 0x61, 0x6c,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
+	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x56, 0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x76, 0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x66,
+	0x66, 0x6c, 0x75, 0x78, 0x2e, 0x41, 0x6e, 0x74, 0x69, 0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x61,
+	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x74, 0x69, 0x62,
+	0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x79, 0x74, 0x6f,
+	0x6b, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x66,
+	0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x63, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x4f, 0x0a, 0x0c,
+	0x45, 0x64, 0x67, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xdb, 0x02,
+	0x0a, 0x0f, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65,
+	0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x72, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x6f, 0x72, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x6f, 0x72, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61,
+	0x75, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x10,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x03, 0x64, 0x6e,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78,
+	0x2e, 0x44, 0x4e, 0x41, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x03, 0x64, 0x6e,
+	0x61, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22,
+	0x92, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x64, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x44, 0x4e, 0x41, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x03, 0x64, 0x6e, 0x61, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75,
+	0x78, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x45, 0x64,
+	0x67, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x66, 0x66, 0x6c, 0x75, 0x78, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x61, 0x67, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x6c, 0x69, 0x6e,
+	0x65, 0x61, 0x67, 0x65, 0x2a, 0xf1, 0x04, 0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x61, 0x63, 0x74, 0x65, 0x72, 0x6f, 0x69,
+	0x64, 0x6f, 0x74, 0x61, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x6f, 0x64, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x10, 0x04,
+	0x12, 0x11, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6f, 0x6d, 0x79, 0x6f, 0x63, 0x79, 0x74,
+	0x65, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x6e, 0x65, 0x75, 0x6d, 0x6f, 0x63, 0x79, 0x74,
+	0x65, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x79, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x07,
+	0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x6f, 0x63, 0x79, 0x74, 0x65,
+	0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x6f, 0x63, 0x79, 0x74, 0x65,
+	0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x6f, 0x64, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x0a,
+	0x12, 0x11, 0x0a, 0x0d, 0x48, 0x65, 0x6d, 0x6f, 0x63, 0x79, 0x74, 0x6f, 0x62, 0x6c, 0x61, 0x73,
+	0x74, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x62, 0x6c, 0x61,
+	0x73, 0x74, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x79, 0x65, 0x6c, 0x6f, 0x62, 0x6c, 0x61,
+	0x73, 0x74, 0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x6f, 0x6e, 0x6f, 0x63, 0x79, 0x74, 0x65,
+	0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x70, 0x68, 0x61, 0x67, 0x6f,
+	0x63, 0x79, 0x74, 0x65, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x65, 0x6e, 0x64, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x65, 0x75, 0x74, 0x72, 0x6f, 0x63,
+	0x79, 0x74, 0x65, 0x10, 0x11, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x61, 0x74, 0x75, 0x72, 0x61, 0x6c,
+	0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x65, 0x6c, 0x6c, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11,
+	0x56, 0x69, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74,
+	0x65, 0x10, 0x13, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x54, 0x4c, 0x79,
+	0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x14, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x54, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10,
+	0x15, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65,
+	0x10, 0x16, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x4c,
+	0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x17, 0x12, 0x14, 0x0a, 0x10, 0x56,
+	0x69, 0x72, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x10,
+	0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x65, 0x70, 0x61, 0x74, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10,
+	0x19, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x68, 0x79, 0x6d, 0x69, 0x63, 0x45, 0x70, 0x69, 0x74, 0x68,
+	0x65, 0x6c, 0x69, 0x61, 0x6c, 0x10, 0x1a, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x68, 0x79, 0x6d, 0x6f,
+	0x63, 0x79, 0x74, 0x65, 0x10, 0x1b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x61, 0x6e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x63, 0x42, 0x65, 0x74, 0x61, 0x10, 0x1c, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x61,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x63, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x10, 0x1d, 0x12,
+	0x15, 0x0a, 0x11, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f,
+	0x63, 0x79, 0x74, 0x65, 0x10, 0x1e, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x42, 0x4c, 0x79, 0x6d, 0x70, 0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x1f, 0x12, 0x19, 0x0a,
+	0x15, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x4c, 0x79, 0x6d, 0x70,
+	0x68, 0x6f, 0x63, 0x79, 0x74, 0x65, 0x10, 0x20, 0x2a, 0xc6, 0x01, 0x0a, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x65, 0x78,
+	0x68, 0x61, 0x6c, 0x65, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x75, 0x6d, 0x70, 0x10, 0x05,
+	0x12, 0x08, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x68,
+	0x69, 0x6e, 0x6b, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x10,
+	0x08, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x10, 0x09, 0x12, 0x10, 0x0a,
+	0x0c, 0x67, 0x6c, 0x79, 0x63, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x10, 0x0a, 0x12,
+	0x12, 0x0a, 0x0e, 0x67, 0x6c, 0x79, 0x63, 0x6f, 0x67, 0x65, 0x6e, 0x6f, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x6f, 0x78, 0x69, 0x66, 0x79, 0x10,
+	0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x10,
+	0x0d, 0x2a, 0xa5, 0x01, 0x0a, 0x0c, 0x43, 0x79, 0x74, 0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x61, 0x6e, 0x74, 0x69, 0x67, 0x65, 0x6e, 0x5f, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x69, 0x6e, 0x64, 0x75,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x6d, 0x6f, 0x74, 0x61, 0x78, 0x69, 0x73, 0x10, 0x04, 0x12,
+	0x0e, 0x0a, 0x0a, 0x63, 0x79, 0x74, 0x6f, 0x74, 0x6f, 0x78, 0x69, 0x6e, 0x73, 0x10, 0x05, 0x12,
+	0x11, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x70, 0x68, 0x79, 0x6c, 0x61, 0x74, 0x6f, 0x78, 0x69, 0x6e,
+	0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x6d, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x07, 0x2a, 0x2e, 0x0a, 0x0b, 0x4e, 0x61, 0x6e,
+	0x6f, 0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x61, 0x6e, 0x6f,
+	0x62, 0x6f, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x10, 0x01, 0x2a, 0x85, 0x01, 0x0a, 0x10, 0x43, 0x65,
+	0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e,
+	0x0a, 0x0a, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x69, 0x6e,
+	0x63, 0x75, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x61, 0x70, 0x6f,
+	0x70, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x64, 0x6f, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x10,
+	0x07, 0x2a, 0x74, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x6f, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x63, 0x79, 0x74,
+	0x6f, 0x6b, 0x69, 0x6e, 0x65, 0x10, 0x07, 0x2a, 0x35, 0x0a, 0x0f, 0x41, 0x6e, 0x74, 0x69, 0x62,
+	0x6f, 0x64, 0x79, 0x49, 0x73, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x67,
+	0x4d, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x67, 0x47, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x49, 0x67, 0x41, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x67, 0x45, 0x10, 0x03, 0x42, 0x08,
+	0x5a, 0x06, 0x2e, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_efflux_proto_rawDescOnce sync.Once
+	file_efflux_proto_rawDescData = file_efflux_proto_rawDesc
+)
+
+func file_efflux_proto_rawDescGZIP() []byte {
+	file_efflux_proto_rawDescOnce.Do(func() {
+		file_efflux_proto_rawDescData = protoimpl.X.CompressGZIP(file_efflux_proto_rawDescData)
+	})
+	return file_efflux_proto_rawDescData
+}
+
+var file_efflux_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_efflux_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_efflux_proto_goTypes = []interface{}{
+	(CellType)(0),                    // 0: efflux.CellType
+	(WorkType)(0),                    // 1: efflux.WorkType
+	(CytokineType)(0),                // 2: efflux.CytokineType
+	(NanobotType)(0),                 // 3: efflux.NanobotType
+	(CellActionStatus)(0),            // 4: efflux.CellActionStatus
+	(InteractionType)(0),             // 5: efflux.InteractionType
+	(AntibodyIsotype)(0),             // 6: efflux.AntibodyIsotype
+	(InteractionResponse_Status)(0),  // 7: efflux.InteractionResponse.Status
+	(*WorkSocketData)(nil),           // 8: efflux.WorkSocketData
+	(*ResourceBlobSocketData)(nil),   // 9: efflux.ResourceBlobSocketData
+	(*WasteBlobSocketData)(nil),      // 10: efflux.WasteBlobSocketData
+	(*HormoneBlobSocketData)(nil),    // 11: efflux.HormoneBlobSocketData
+	(*AntigenBlobSocketData)(nil),    // 12: efflux.AntigenBlobSocketData
+	(*DiffusionSocketData)(nil),      // 13: efflux.DiffusionSocketData
+	(*WorkStatusSocketData)(nil),     // 14: efflux.WorkStatusSocketData
+	(*MaterialStatusSocketData)(nil), // 15: efflux.MaterialStatusSocketData
+	(*StatusSocketData)(nil),         // 16: efflux.StatusSocketData
+	(*RenderType)(nil),               // 17: efflux.RenderType
+	(*RenderableSocketData)(nil),     // 18: efflux.RenderableSocketData
+	(*Position)(nil),                 // 19: efflux.Position
+	(*CellStatus)(nil),               // 20: efflux.CellStatus
+	(*InteractionLoginRequest)(nil),  // 21: efflux.InteractionLoginRequest
+	(*InteractionLoginResponse)(nil), // 22: efflux.InteractionLoginResponse
+	(*InteractionRequest)(nil),       // 23: efflux.InteractionRequest
+	(*InteractionResponse)(nil),      // 24: efflux.InteractionResponse
+	(*DNASnapshot)(nil),              // 25: efflux.DNASnapshot
+	(*ViralLoadSnapshot)(nil),        // 26: efflux.ViralLoadSnapshot
+	(*AntibodyLoadSnapshot)(nil),     // 27: efflux.AntibodyLoadSnapshot
+	(*CytokineSnapshot)(nil),         // 28: efflux.CytokineSnapshot
+	(*CellSnapshot)(nil),             // 29: efflux.CellSnapshot
+	(*NodeSnapshot)(nil),             // 30: efflux.NodeSnapshot
+	(*EdgeSnapshot)(nil),             // 31: efflux.EdgeSnapshot
+	(*LineageSnapshot)(nil),          // 32: efflux.LineageSnapshot
+	(*TransportRequest)(nil),         // 33: efflux.TransportRequest
+	(*Snapshot)(nil),                 // 34: efflux.Snapshot
+}
+var file_efflux_proto_depIdxs = []int32{
+	13, // 0: efflux.WorkSocketData.diffusion:type_name -> efflux.DiffusionSocketData
+	6,  // 1: efflux.AntigenBlobSocketData.antibody_isotypes:type_name -> efflux.AntibodyIsotype
+	9,  // 2: efflux.DiffusionSocketData.resources:type_name -> efflux.ResourceBlobSocketData
+	10, // 3: efflux.DiffusionSocketData.waste:type_name -> efflux.WasteBlobSocketData
+	11, // 4: efflux.DiffusionSocketData.hormone:type_name -> efflux.HormoneBlobSocketData
+	12, // 5: efflux.DiffusionSocketData.antigen:type_name -> efflux.AntigenBlobSocketData
+	14, // 6: efflux.StatusSocketData.work_status:type_name -> efflux.WorkStatusSocketData
+	15, // 7: efflux.StatusSocketData.material_status:type_name -> efflux.MaterialStatusSocketData
+	0,  // 8: efflux.RenderType.cell_type:type_name -> efflux.CellType
+	2,  // 9: efflux.RenderType.cytokine_type:type_name -> efflux.CytokineType
+	3,  // 10: efflux.RenderType.nanobot_type:type_name -> efflux.NanobotType
+	19, // 11: efflux.RenderableSocketData.position:type_name -> efflux.Position
+	17, // 12: efflux.RenderableSocketData.type:type_name -> efflux.RenderType
+	0,  // 13: efflux.CellStatus.cell_type:type_name -> efflux.CellType
+	4,  // 14: efflux.CellStatus.cell_actions:type_name -> efflux.CellActionStatus
+	5,  // 15: efflux.InteractionRequest.type:type_name -> efflux.InteractionType
+	19, // 16: efflux.InteractionRequest.position:type_name -> efflux.Position
+	2,  // 17: efflux.InteractionRequest.cytokine_type:type_name -> efflux.CytokineType
+	5,  // 18: efflux.InteractionResponse.type:type_name -> efflux.InteractionType
+	7,  // 19: efflux.InteractionResponse.status:type_name -> efflux.InteractionResponse.Status
+	20, // 20: efflux.InteractionResponse.target_cell_status:type_name -> efflux.CellStatus
+	20, // 21: efflux.InteractionResponse.attached_cell_status:type_name -> efflux.CellStatus
+	0,  // 22: efflux.ViralLoadSnapshot.target_cell_type:type_name -> efflux.CellType
+	6,  // 23: efflux.AntibodyLoadSnapshot.isotype:type_name -> efflux.AntibodyIsotype
+	19, // 24: efflux.CytokineSnapshot.position:type_name -> efflux.Position
+	2,  // 25: efflux.CytokineSnapshot.cytokine_type:type_name -> efflux.CytokineType
+	0,  // 26: efflux.CellSnapshot.cell_type:type_name -> efflux.CellType
+	1,  // 27: efflux.CellSnapshot.work_type:type_name -> efflux.WorkType
+	19, // 28: efflux.CellSnapshot.position:type_name -> efflux.Position
+	19, // 29: efflux.CellSnapshot.target:type_name -> efflux.Position
+	26, // 30: efflux.CellSnapshot.viral_load:type_name -> efflux.ViralLoadSnapshot
+	27, // 31: efflux.CellSnapshot.antibody_load:type_name -> efflux.AntibodyLoadSnapshot
+	4,  // 32: efflux.CellSnapshot.cell_actions:type_name -> efflux.CellActionStatus
+	9,  // 33: efflux.CellSnapshot.resource_need:type_name -> efflux.ResourceBlobSocketData
+	6,  // 34: efflux.CellSnapshot.isotype:type_name -> efflux.AntibodyIsotype
+	15, // 35: efflux.NodeSnapshot.materials:type_name -> efflux.MaterialStatusSocketData
+	26, // 36: efflux.NodeSnapshot.viral_loads:type_name -> efflux.ViralLoadSnapshot
+	27, // 37: efflux.NodeSnapshot.antibody_loads:type_name -> efflux.AntibodyLoadSnapshot
+	28, // 38: efflux.NodeSnapshot.cytokines:type_name -> efflux.CytokineSnapshot
+	29, // 39: efflux.NodeSnapshot.cells:type_name -> efflux.CellSnapshot
+	0,  // 40: efflux.LineageSnapshot.cell_type:type_name -> efflux.CellType
+	29, // 41: efflux.TransportRequest.cell:type_name -> efflux.CellSnapshot
+	25, // 42: efflux.TransportRequest.dna:type_name -> efflux.DNASnapshot
+	25, // 43: efflux.Snapshot.dna:type_name -> efflux.DNASnapshot
+	30, // 44: efflux.Snapshot.nodes:type_name -> efflux.NodeSnapshot
+	31, // 45: efflux.Snapshot.edges:type_name -> efflux.EdgeSnapshot
+	32, // 46: efflux.Snapshot.lineage:type_name -> efflux.LineageSnapshot
+	47, // [47:47] is the sub-list for method output_type
+	47, // [47:47] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_efflux_proto_init() }
+func file_efflux_proto_init() {
+	if File_efflux_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_efflux_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkSocketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceBlobSocketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WasteBlobSocketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HormoneBlobSocketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AntigenBlobSocketData); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -2402,6 +3953,126 @@ func file_efflux_proto_init() {
 				return nil
 			}
 		}
+		file_efflux_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNASnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViralLoadSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AntibodyLoadSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CytokineSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineageSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_efflux_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_efflux_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*RenderType_CellType)(nil),
@@ -2413,8 +4084,8 @@ func file_efflux_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_efflux_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   17,
+			NumEnums:      8,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
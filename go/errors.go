@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrEdgeDown = errors.New("edge is down")
+var ErrHeartbeatTimeout = errors.New("heartbeat went unanswered")
+
+// ConnectionError means a work connection can no longer be used, so it should
+// be closed and, if it belongs to an edge, dialed again.
+type ConnectionError struct {
+	Op      string // dial, send or receive
+	Address string
+	Err     error
+}
+
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("%v %v: %v", e.Op, e.Address, e.Err)
+}
+
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
+
+// ProtocolError is a message that arrived intact but can't be used, like a
+// frame that doesn't parse or work sent in the wrong direction. The connection
+// is still good, so the message is dropped.
+type ProtocolError struct {
+	Address string
+	Reason  string
+	Err     error
+}
+
+func (e *ProtocolError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("protocol error from %v: %v: %v", e.Address, e.Reason, e.Err)
+	}
+	return fmt.Sprintf("protocol error from %v: %v", e.Address, e.Reason)
+}
+
+func (e *ProtocolError) Unwrap() error {
+	return e.Err
+}
+
+// RequestError is a transport request that no cell can be made from, like one
+// with DNA that doesn't parse. The sender gets a 400.
+type RequestError struct {
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("bad transport request: %v", e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// RemoteNodeError is an admin request that couldn't be forwarded to the
+// `efflux node` process hosting a node. The admin gets a 502.
+type RemoteNodeError struct {
+	Node string
+	Err  error
+}
+
+func (e *RemoteNodeError) Error() string {
+	return fmt.Sprintf("node %q is hosted by another process: %v", e.Node, e.Err)
+}
+
+func (e *RemoteNodeError) Unwrap() error {
+	return e.Err
+}
+
+// UnknownDNAError is DNA referenced by an ID the receiver has never seen. The
+// sender gets a 409, and sends the DNA in full.
+type UnknownDNAError struct {
+	ID string
+}
+
+func (e *UnknownDNAError) Error() string {
+	return fmt.Sprintf("unknown DNA: %v", e.ID)
+}
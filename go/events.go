@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type EventType string
+
+const (
+	SPAWN_EVENT           EventType = "spawn"
+	INFECTION_EVENT       EventType = "infection"
+	APOPTOSIS_EVENT       EventType = "apoptosis"
+	MITOSIS_EVENT         EventType = "mitosis"
+	DIFFERENTIATION_EVENT EventType = "differentiation"
+	ACTIVATION_EVENT      EventType = "activation"
+	ANTIBODY_EVENT        EventType = "antibody_deposit"
+	TRANSPORT_EVENT       EventType = "transport"
+	NANOBOT_EVENT         EventType = "nanobot_interaction"
+	VACCINATION_EVENT     EventType = "vaccination"
+	EXPOSURE_EVENT        EventType = "exposure"
+)
+
+type ApoptosisCause string
+
+const (
+	DAMAGE_CAUSE             ApoptosisCause = "damage"
+	VIRAL_BURST_CAUSE        ApoptosisCause = "viral_burst"
+	LIFESPAN_CAUSE           ApoptosisCause = "lifespan"
+	PHAGOCYTOSIS_CAUSE       ApoptosisCause = "phagocytosis"
+	KILL_SWITCH_CAUSE        ApoptosisCause = "kill_switch"
+	NEGATIVE_SELECTION_CAUSE ApoptosisCause = "negative_selection"
+)
+
+type Event struct {
+	Type     EventType      `json:"type"`
+	Time     time.Time      `json:"time"`
+	Node     string         `json:"node"`
+	CellId   string         `json:"cellId"`
+	CellType string         `json:"cellType,omitempty"`
+	Lineage  string         `json:"lineage,omitempty"`
+	Parent   string         `json:"parent,omitempty"` // Lineage of the cell this one divided or differentiated from.
+	Cause    ApoptosisCause `json:"cause,omitempty"`
+	Target   string         `json:"target,omitempty"` // Whatever the cell acted on, like a virus or a node.
+	Detail   string         `json:"detail,omitempty"`
+}
+
+// EventFilter matches events on any of its non-empty fields.
+type EventFilter struct {
+	CellId string
+	Type   EventType
+	Node   string
+}
+
+func (f EventFilter) Match(e Event) bool {
+	return (f.CellId == "" || f.CellId == e.CellId || f.CellId == e.Lineage || f.CellId == e.Parent) &&
+		(f.Type == "" || f.Type == e.Type) &&
+		(f.Node == "" || f.Node == e.Node)
+}
+
+// EventLog fans events out to an optional JSONL writer and any subscribers.
+// Subscribers that fall behind miss events rather than slow the simulation.
+type EventLog struct {
+	sync.Mutex
+	encoder     *json.Encoder
+	subscribers map[chan Event]EventFilter
+}
+
+func InitializeEventLog() *EventLog {
+	return &EventLog{
+		subscribers: map[chan Event]EventFilter{},
+	}
+}
+
+func (l *EventLog) SetWriter(w io.Writer) {
+	l.Lock()
+	defer l.Unlock()
+	l.encoder = json.NewEncoder(w)
+}
+
+func (l *EventLog) Emit(e Event) {
+	if l == nil {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	if l.encoder != nil {
+		err := l.encoder.Encode(e)
+		if err != nil {
+			fmt.Println("Unable to log event:", err)
+		}
+	}
+	for subscriber, filter := range l.subscribers {
+		if filter.Match(e) {
+			select {
+			case subscriber <- e:
+			default:
+			}
+		}
+	}
+}
+
+func (l *EventLog) Subscribe(filter EventFilter) (chan Event, func()) {
+	l.Lock()
+	defer l.Unlock()
+	subscriber := make(chan Event, EVENT_BUFFER_SIZE)
+	l.subscribers[subscriber] = filter
+	return subscriber, func() {
+		l.Lock()
+		defer l.Unlock()
+		delete(l.subscribers, subscriber)
+	}
+}
+
+func ParseEventFilter(r *http.Request) EventFilter {
+	query := r.URL.Query()
+	return EventFilter{
+		CellId: query.Get("cell"),
+		Type:   EventType(query.Get("type")),
+		Node:   query.Get("node"),
+	}
+}
+
+// StreamEvents sends matching events to a websocket as JSON, e.g.
+// /events?type=infection&node=Left%20Lung.
+func (l *EventLog) StreamEvents(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	filter := ParseEventFilter(r)
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		fmt.Println("Event socket failed:", err)
+		return
+	}
+	connection := &Connection{Conn: conn}
+	defer connection.Close()
+	events, unsubscribe := l.Subscribe(filter)
+	defer unsubscribe()
+	// Notice when the client goes away.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := connection.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closed:
+			return
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				fmt.Println("Unable to encode event:", err)
+				continue
+			}
+			if err := connection.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (n *Node) LogEvent(event Event) {
+	event.Time = n.clock.Now()
+	event.Node = n.name
+	n.events.Emit(event)
+}
+
+func ReadEvents(r io.Reader, filter EventFilter, found func(Event)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return fmt.Errorf("events: line %v: %w", line, err)
+		}
+		if filter.Match(event) {
+			found(event)
+		}
+	}
+	return scanner.Err()
+}
+
+// RunEventsCommand implements `efflux events [flags] log.jsonl`, which prints
+// the matching events from a log, one per line.
+func RunEventsCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("events", flag.ContinueOnError)
+	cellId := flags.String("cell", "", "Only events for this cell render ID or lineage, or its children.")
+	eventType := flags.String("type", "", "Only events of this type, like infection or apoptosis.")
+	node := flags.String("node", "", "Only events in this node.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	filter := EventFilter{
+		CellId: *cellId,
+		Type:   EventType(*eventType),
+		Node:   *node,
+	}
+	in := io.Reader(os.Stdin)
+	if flags.NArg() > 0 {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return fmt.Errorf("events: %w", err)
+		}
+		defer file.Close()
+		in = file
+	}
+	encoder := json.NewEncoder(out)
+	return ReadEvents(in, filter, func(event Event) {
+		encoder.Encode(event)
+	})
+}
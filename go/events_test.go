@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventLog(t *testing.T) {
+	log := InitializeEventLog()
+	out := &bytes.Buffer{}
+	log.SetWriter(out)
+	infections, unsubscribe := log.Subscribe(EventFilter{Type: INFECTION_EVENT})
+	defer unsubscribe()
+
+	log.Emit(Event{Type: SPAWN_EVENT, Node: "Heart", CellId: "Cardiomyocyte00000001"})
+	log.Emit(Event{Type: INFECTION_EVENT, Node: "Lung", CellId: "Pneumocyte00000002", Target: "covid"})
+	log.Emit(Event{Type: APOPTOSIS_EVENT, Node: "Lung", CellId: "Pneumocyte00000002", Cause: VIRAL_BURST_CAUSE})
+
+	select {
+	case event := <-infections:
+		if event.CellId != "Pneumocyte00000002" || event.Target != "covid" {
+			t.Errorf("got %v, want the covid infection", event)
+		}
+	default:
+		t.Fatal("expected an infection event")
+	}
+	if len(infections) != 0 {
+		t.Errorf("expected only infection events, got %v more", len(infections))
+	}
+	if lines := strings.Count(out.String(), "\n"); lines != 3 {
+		t.Errorf("got %v lines of JSONL, want 3", lines)
+	}
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	if err := os.WriteFile(path, out.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	filtered := &bytes.Buffer{}
+	err := RunEventsCommand([]string{"-type", "apoptosis", path}, filtered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(filtered.String(), `"cause":"viral_burst"`) || strings.Count(filtered.String(), "\n") != 1 {
+		t.Errorf("got %q, want only the apoptosis", filtered)
+	}
+
+	var found []Event
+	err = ReadEvents(bytes.NewReader(out.Bytes()), EventFilter{CellId: "Pneumocyte00000002", Node: "Lung"}, func(event Event) {
+		found = append(found, event)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 || found[1].Cause != VIRAL_BURST_CAUSE {
+		t.Errorf("got %v, want the infection and the apoptosis", found)
+	}
+}
+
+func TestCellEvents(t *testing.T) {
+	dnas := &DNAIndex{}
+	dnas.Add(MakeDNA(HUMAN_DNA, HUMAN_NAME))
+	body, err := RestoreBody(context.Background(), &Snapshot{
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Dna:    dnas.dna,
+		Nodes: []*NodeSnapshot{
+			{
+				Name:  "Events Heart",
+				Organ: "heart",
+				Cells: []*CellSnapshot{
+					{CellType: CellType_Cardiomyocyte, WorkType: WorkType_pump, RenderId: "Cardiomyocyte00000001", SpawnTime: time.Unix(1000, 0).UnixNano()},
+				},
+			},
+		},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, unsubscribe := body.events.Subscribe(EventFilter{CellId: "Cardiomyocyte00000001"})
+	defer unsubscribe()
+	cell := body.FindNode("Events Heart").Cells()[0]
+	cell.Apoptosis(false, KILL_SWITCH_CAUSE)
+
+	select {
+	case event := <-events:
+		want := Event{
+			Type:     APOPTOSIS_EVENT,
+			Time:     time.Unix(1000, 0),
+			Node:     "Events Heart",
+			CellId:   "Cardiomyocyte00000001",
+			CellType: "Cardiomyocyte",
+			Lineage:  cell.Lineage().ID,
+			Cause:    KILL_SWITCH_CAUSE,
+		}
+		if !event.Time.Equal(want.Time) {
+			t.Errorf("got time %v, want %v", event.Time, want.Time)
+		}
+		event.Time = want.Time
+		if event != want {
+			t.Errorf("got %+v, want %+v", event, want)
+		}
+	default:
+		t.Fatal("expected an apoptosis event")
+	}
+}
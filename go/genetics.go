@@ -3,14 +3,14 @@ package main
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
-	"crypto/rand"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/binary"
 	"fmt"
 	"log"
-	mathRand "math/rand"
-	"time"
+	"math/big"
+	"math/rand"
+	"sync"
 )
 
 type DNAType elliptic.Curve
@@ -34,6 +34,7 @@ var DNATypeMap = map[int]DNAType{
 }
 
 type DNA struct {
+	id           string // Identifies the name and base across processes.
 	name         string
 	base         *ecdsa.PrivateKey
 	dnaType      DNAType
@@ -51,46 +52,137 @@ type Antigen struct {
 }
 
 func MakeDNA(dnaType DNAType, name string) *DNA {
-	// Caution: slow!
-	privateKey, err := ecdsa.GenerateKey(dnaType, rand.Reader)
-	if err != nil {
-		log.Fatal(err)
-	}
 	dna := &DNA{
 		name:    name,
-		base:    privateKey,
+		base:    GenerateKey(dnaType, MakeRand("dna/"+name)),
 		dnaType: dnaType,
 	}
+	dna.id = MakeDNAId(name, dnaType, dna.base)
 	dna.Initialize()
+	return dnaRegistry.Add(dna)
+}
+
+// DNARegistry holds every DNA made or received by the process, so transported
+// cells can refer to their DNA by ID instead of parsing and initializing it
+// again on every hop.
+type DNARegistry struct {
+	sync.RWMutex
+	dna map[string]*DNA
+}
+
+var dnaRegistry = &DNARegistry{
+	dna: map[string]*DNA{},
+}
+
+// Add returns the DNA already registered under the same ID, if there is one.
+func (r *DNARegistry) Add(dna *DNA) *DNA {
+	r.Lock()
+	defer r.Unlock()
+	if existing, ok := r.dna[dna.id]; ok {
+		return existing
+	}
+	r.dna[dna.id] = dna
 	return dna
 }
 
-func MakeDNAFromRequest(request TransportRequest) (*DNA, error) {
-	privateKey, err := x509.ParseECPrivateKey(request.Base)
+func (r *DNARegistry) Find(id string) *DNA {
+	r.RLock()
+	defer r.RUnlock()
+	return r.dna[id]
+}
+
+func MakeDNAId(name string, dnaType DNAType, base *ecdsa.PrivateKey) string {
+	h := sha256.New()
+	h.Write([]byte(name))
+	binary.Write(h, binary.LittleEndian, int32(dnaType.Params().BitSize))
+	h.Write(base.D.Bytes())
+	return fmt.Sprintf("%x", h.Sum(nil)[:16])
+}
+
+// GenerateKey derives the private key from the random stream alone, unlike
+// ecdsa.GenerateKey, which may read an extra byte so that callers can't rely
+// on its output being deterministic.
+func GenerateKey(dnaType DNAType, random *rand.Rand) *ecdsa.PrivateKey {
+	params := dnaType.Params()
+	b := make([]byte, params.BitSize/8+8)
+	random.Read(b)
+	one := big.NewInt(1)
+	d := new(big.Int).SetBytes(b)
+	d.Mod(d, new(big.Int).Sub(params.N, one))
+	d.Add(d, one)
+	privateKey := &ecdsa.PrivateKey{D: d}
+	privateKey.PublicKey.Curve = dnaType
+	privateKey.PublicKey.X, privateKey.PublicKey.Y = dnaType.ScalarBaseMult(d.Bytes())
+	return privateKey
+}
+
+func MakeDNAFromSnapshot(snapshot *DNASnapshot) (*DNA, error) {
+	privateKey, err := x509.ParseECPrivateKey(snapshot.Base)
 	if err != nil {
 		return nil, err
 	}
-	dNAType, ok := DNATypeMap[request.DNAType]
+	dNAType, ok := DNATypeMap[int(snapshot.DnaType)]
 	if !ok {
-		return nil, fmt.Errorf("cannot find DNA Type: %v", request.DNAType)
+		return nil, fmt.Errorf("cannot find DNA Type: %v", snapshot.DnaType)
 	}
 	dna := &DNA{
-		name:    request.Name,
+		name:    snapshot.Name,
 		base:    privateKey,
 		dnaType: dNAType,
 	}
+	dna.id = MakeDNAId(dna.name, dna.dnaType, dna.base)
+	if snapshot.Id != "" && snapshot.Id != dna.id {
+		return nil, fmt.Errorf("DNA %q does not match its ID %v", snapshot.Name, snapshot.Id)
+	}
 	dna.Initialize()
-	return dna, nil
+	return dnaRegistry.Add(dna), nil
+}
+
+// ResolveDNA finds DNA referenced by ID in the registry, and parses the rest.
+func ResolveDNA(snapshots []*DNASnapshot) ([]*DNA, error) {
+	var dnas []*DNA
+	for _, snapshot := range snapshots {
+		if snapshot.Id != "" {
+			if dna := dnaRegistry.Find(snapshot.Id); dna != nil {
+				dnas = append(dnas, dna)
+				continue
+			}
+			if len(snapshot.Base) == 0 {
+				return nil, &UnknownDNAError{ID: snapshot.Id}
+			}
+		}
+		dna, err := MakeDNAFromSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		dnas = append(dnas, dna)
+	}
+	return dnas, nil
+}
+
+// Rename returns the same DNA under another name, like a pathogen named by a
+// scenario.
+func (d *DNA) Rename(name string) *DNA {
+	if name == d.name {
+		return d
+	}
+	id := MakeDNAId(name, d.dnaType, d.base)
+	if dna := dnaRegistry.Find(id); dna != nil {
+		return dna
+	}
+	return dnaRegistry.Add(&DNA{
+		id:           id,
+		name:         name,
+		base:         d.base,
+		dnaType:      d.dnaType,
+		selfProteins: d.selfProteins,
+		makeFunction: d.makeFunction,
+	})
 }
 
 func MakeVirusDNA(name string, targetCellType CellType) *DNA {
 	virusDNA := MakeDNA(VIRUS_RNA, name)
-	for foundType := CellType(0); targetCellType != foundType; foundType = CopyViralLoadCarrier(&VirusCarrier{
-		Cell: &Cell{
-			dna: virusDNA,
-		},
-		virus: &Virus{},
-	}).virus.targetCellType {
+	for foundType := CellType(0); targetCellType != foundType; foundType = (&VirusCarrier{}).GetTargetCellType(virusDNA) {
 		virusDNA = MakeDNA(VIRUS_RNA, name)
 	}
 	return virusDNA
@@ -99,10 +191,8 @@ func MakeVirusDNA(name string, targetCellType CellType) *DNA {
 func (d *DNA) Initialize() {
 	d.selfProteins = d.GenerateSelfProteins()
 	switch d.dnaType {
-	case HUMAN_DNA:
-		d.makeFunction = MakeStateDiagramByEukaryote
-	case BACTERIA_DNA:
-		d.makeFunction = MakeStateDiagramByProkaryote
+	case HUMAN_DNA, BACTERIA_DNA:
+		d.makeFunction = MakeStateDiagramByCellType
 	case VIRUS_RNA:
 		d.makeFunction = MakeStateDiagramByVirus
 	}
@@ -112,6 +202,19 @@ func (d *DNA) Serialize() ([]byte, error) {
 	return x509.MarshalECPrivateKey(d.base)
 }
 
+func (d *DNA) Snapshot() (*DNASnapshot, error) {
+	base, err := d.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return &DNASnapshot{
+		Id:      d.id,
+		Name:    d.name,
+		DnaType: int32(d.dnaType.Params().BitSize),
+		Base:    base,
+	}, nil
+}
+
 func (d *DNA) MHC_I() MHC_I {
 	return &d.base.PublicKey
 }
@@ -194,15 +297,24 @@ func (d *DNA) GenerateNonselfProteins() (proteins []Protein) {
 	return
 }
 
+// GenerateReceptors makes a random receptor set which, unlike the groups from
+// Generate_MHCII_Groups, may bind self proteins until selected against.
+func GenerateReceptors(random *rand.Rand, count int) map[Protein]bool {
+	receptors := make(map[Protein]bool)
+	for len(receptors) < count {
+		receptors[Protein(random.Intn(65535))] = true
+	}
+	return receptors
+}
+
 func (d *DNA) Generate_MHCII_Groups(count int) (mhc_ii_groups []map[Protein]bool) {
 	for i := 0; i < count; i++ {
 		mhc_ii_groups = append(mhc_ii_groups, make(map[Protein]bool))
 	}
 	proteins := d.GenerateNonselfProteins()
-	mathRand.Seed(time.Now().UnixNano())
-	mathRand.Shuffle(len(proteins), func(i, j int) { proteins[i], proteins[j] = proteins[j], proteins[i] })
+	MakeRand("mhc_ii/"+d.name).Shuffle(len(proteins), func(i, j int) { proteins[i], proteins[j] = proteins[j], proteins[i] })
 	for i, protein := range proteins {
-		mhc_ii_groups[i%VIRGIN_TCELL_COUNT][protein] = true
+		mhc_ii_groups[i%count][protein] = true
 	}
 	return mhc_ii_groups
 }
@@ -85,3 +85,63 @@ func TestCellVerification(t *testing.T) {
 	}
 
 }
+
+func TestSeededDNA(t *testing.T) {
+	SetSeed(42)
+	bacteriaDNA := MakeDNA(BACTERIA_DNA, "E. Coli")
+	otherBacteriaDNA := MakeDNA(BACTERIA_DNA, "E. Coli")
+	mhc_ii_groups := bacteriaDNA.Generate_MHCII_Groups(3)
+	SetSeed(42)
+	seededDNA := MakeDNA(BACTERIA_DNA, "E. Coli")
+	seeded_mhc_ii_groups := seededDNA.Generate_MHCII_Groups(3)
+
+	if bacteriaDNA.base.D.Cmp(seededDNA.base.D) != 0 {
+		t.Errorf("expected the same seed to make the same DNA")
+	}
+	if bacteriaDNA.base.D.Cmp(otherBacteriaDNA.base.D) == 0 {
+		t.Errorf("expected each strain to have different DNA")
+	}
+	if !bacteriaDNA.VerifySelf(seededDNA.MHC_I(), seededDNA.GenerateAntigen(seededDNA.selfProteins)) {
+		t.Errorf("expected seeded DNA to verify as self")
+	}
+	for i := range mhc_ii_groups {
+		if len(mhc_ii_groups[i]) != len(seeded_mhc_ii_groups[i]) {
+			t.Fatalf("expected the same seed to make the same MHC II groups")
+		}
+		for protein := range mhc_ii_groups[i] {
+			if !seeded_mhc_ii_groups[i][protein] {
+				t.Fatalf("expected the same seed to make the same MHC II groups")
+			}
+		}
+	}
+}
+
+func TestVirusTargets(t *testing.T) {
+	for _, cellType := range []CellType{CellType_Hepatocyte, CellType_PancreaticBeta, CellType_MemoryTLymphocyte} {
+		virusDNA := MakeVirusDNA("Target Virus", cellType)
+		if target := (&VirusCarrier{}).GetTargetCellType(virusDNA); target != cellType {
+			t.Errorf("Expected a virus that targets %v, got: %v", cellType, target)
+		}
+	}
+	for _, cellType := range InfectableCellTypes() {
+		if LookupCellType(cellType).DNAType != HUMAN_DNA {
+			t.Errorf("Expected viruses to only target human cells, got: %v", cellType)
+		}
+	}
+	if _, err := ParseScenario([]byte(`{"exposures": [{"pathogen": "Coxsackie", "kind": "virus", "targetCellType": "PancreaticBeta", "node": "Pancreas", "dose": 1}]}`)); err != nil {
+		t.Error(err)
+	}
+
+	pathogens := &PathogenRegistry{}
+	liver := Exposure{Pathogen: "Registry Virus", Kind: VIRUS_PATHOGEN, TargetCellType: CellType_Hepatocyte.String()}
+	pancreas := Exposure{Pathogen: "Registry Virus", Kind: VIRUS_PATHOGEN, TargetCellType: CellType_PancreaticBeta.String()}
+	if target := (&VirusCarrier{}).GetTargetCellType(pathogens.GetDNA(liver)); target != CellType_Hepatocyte {
+		t.Errorf("Expected the virus to target the liver, got: %v", target)
+	}
+	if target := (&VirusCarrier{}).GetTargetCellType(pathogens.GetDNA(pancreas)); target != CellType_PancreaticBeta {
+		t.Errorf("Expected a virus of the same name with another target to be another virus, got: %v", target)
+	}
+	if pathogens.GetDNA(liver) != pathogens.GetDNA(liver) {
+		t.Error("Expected a reinfection to share the virus's DNA")
+	}
+}
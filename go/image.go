@@ -13,7 +13,6 @@ import (
 	"math/rand"
 	"os"
 	"strconv"
-	"time"
 )
 
 type BaseImage struct {
@@ -126,13 +125,13 @@ func (l Line) InBounds(pt image.Point) bool {
 	return d <= float64(l.width*l.width)/4
 }
 
-func (l Line) GetRandPoint() image.Point {
+func (l Line) GetRandPoint(random *rand.Rand) image.Point {
 	p0, p1 := l.p0, l.p1
 	if p0.X == p1.X {
-		return image.Pt(p0.X, RandInRange(p0.Y, p1.Y))
+		return image.Pt(p0.X, RandInRange(random, p0.Y, p1.Y))
 	}
 	m := l.Slope()
-	x := RandInRange(p0.X, p0.X)
+	x := RandInRange(random, p0.X, p0.X)
 	y := (p1.Y-p0.Y)*m + p0.Y
 	return image.Pt(x, y)
 }
@@ -151,7 +150,7 @@ func (c Circle) InBounds(pt image.Point) bool {
 	return c.Distance(pt) < float64(c.radius)
 }
 
-func RandInRange(x, y int) int {
+func RandInRange(random *rand.Rand, x, y int) int {
 	var min, max int
 	if x < y {
 		min = x
@@ -163,25 +162,24 @@ func RandInRange(x, y int) int {
 	if min == max {
 		return min
 	}
-	rand.Seed(time.Now().UnixNano())
 	if min < 0 && max < 0 {
-		return rand.Intn(-min+max) + min
+		return random.Intn(-min+max) + min
 	} else {
-		return rand.Intn(max-min) + min
+		return random.Intn(max-min) + min
 	}
 }
 
-func MakeRandPoint(rect image.Rectangle) image.Point {
-	x0 := RandInRange(rect.Min.X, rect.Max.X)
-	y0 := RandInRange(rect.Min.Y, rect.Max.Y)
+func MakeRandPoint(random *rand.Rand, rect image.Rectangle) image.Point {
+	x0 := RandInRange(random, rect.Min.X, rect.Max.X)
+	y0 := RandInRange(random, rect.Min.Y, rect.Max.Y)
 	return image.Pt(x0, y0)
 }
 
-func MakeRandRect(rect image.Rectangle) image.Rectangle {
-	x0 := RandInRange(rect.Min.X, rect.Max.X)
-	y0 := RandInRange(rect.Min.Y, rect.Max.Y)
-	x1 := RandInRange(rect.Min.X, rect.Max.X)
-	y1 := RandInRange(rect.Min.Y, rect.Max.Y)
+func MakeRandRect(random *rand.Rand, rect image.Rectangle) image.Rectangle {
+	x0 := RandInRange(random, rect.Min.X, rect.Max.X)
+	y0 := RandInRange(random, rect.Min.Y, rect.Max.Y)
+	x1 := RandInRange(random, rect.Min.X, rect.Max.X)
+	y1 := RandInRange(random, rect.Min.Y, rect.Max.Y)
 	return image.Rect(x0, y0, x1, y1).Intersect(rect)
 }
 
@@ -9,6 +9,7 @@ type ResourceBlob struct {
 	o2       int
 	glucose  int
 	vitamins int
+	glycogen int // Stored by liver cells, never diffuses.
 }
 
 func offset(val int) (offset int) {
@@ -46,6 +47,7 @@ func (r *ResourceBlob) Add(resource *ResourceBlob) {
 	r.o2 += resource.o2
 	r.vitamins += resource.vitamins
 	r.glucose += resource.glucose
+	r.glycogen += resource.glycogen
 }
 
 func (r *ResourceBlob) Split() *ResourceBlob {
@@ -53,7 +55,9 @@ func (r *ResourceBlob) Split() *ResourceBlob {
 		o2:       0,
 		glucose:  0,
 		vitamins: 0,
+		glycogen: r.glycogen,
 	}
+	r.glycogen = 0
 	if r.o2 > 1 {
 		offset := offset(r.o2)
 		r.o2 /= 2
@@ -75,17 +79,20 @@ func (r *ResourceBlob) Split() *ResourceBlob {
 type WasteBlob struct {
 	co2        int
 	creatinine int
+	ammonia    int
 }
 
 func (w *WasteBlob) Add(waste *WasteBlob) {
 	w.co2 += waste.co2
 	w.creatinine += waste.creatinine
+	w.ammonia += waste.ammonia
 }
 
 func (w *WasteBlob) Split() *WasteBlob {
 	keep := &WasteBlob{
 		co2:        0,
 		creatinine: 0,
+		ammonia:    0,
 	}
 	if w.co2 > 1 {
 		offset := offset(w.co2)
@@ -97,6 +104,11 @@ func (w *WasteBlob) Split() *WasteBlob {
 		w.creatinine /= 2
 		keep.creatinine += w.creatinine + offset
 	}
+	if w.ammonia > 1 {
+		offset := offset(w.ammonia)
+		w.ammonia /= 2
+		keep.ammonia += w.ammonia + offset
+	}
 	return keep
 }
 
@@ -152,6 +164,10 @@ type HormoneBlob struct {
 	macrophage_csf  int // Produces Monocyte.
 	interleukin_3   int // Produces Lymphoblast.
 	interleukin_2   int // Induces TCell mitosis.
+	complement      int // Secreted by liver cells.
+	insulin         int // Lets cells take up glucose, and the liver store it.
+	glucagon        int // Has the liver release glucose.
+	interleukin_10  int // Brakes TCell mitosis.
 }
 
 func (h *HormoneBlob) Add(hormone *HormoneBlob) {
@@ -159,6 +175,10 @@ func (h *HormoneBlob) Add(hormone *HormoneBlob) {
 	h.macrophage_csf += hormone.macrophage_csf
 	h.interleukin_3 += hormone.interleukin_3
 	h.interleukin_2 += hormone.interleukin_2
+	h.complement += hormone.complement
+	h.insulin += hormone.insulin
+	h.glucagon += hormone.glucagon
+	h.interleukin_10 += hormone.interleukin_10
 }
 
 func (h *HormoneBlob) Split() *HormoneBlob {
@@ -167,6 +187,10 @@ func (h *HormoneBlob) Split() *HormoneBlob {
 		macrophage_csf:  0,
 		interleukin_3:   0,
 		interleukin_2:   0,
+		complement:      0,
+		insulin:         0,
+		glucagon:        0,
+		interleukin_10:  0,
 	}
 	if h.granulocyte_csf > 1 {
 		offset := offset(h.granulocyte_csf)
@@ -188,6 +212,26 @@ func (h *HormoneBlob) Split() *HormoneBlob {
 		h.interleukin_2 /= 2
 		keep.interleukin_2 += h.interleukin_2 + offset
 	}
+	if h.complement > 1 {
+		offset := offset(h.complement)
+		h.complement /= 2
+		keep.complement += h.complement + offset
+	}
+	if h.insulin > 1 {
+		offset := offset(h.insulin)
+		h.insulin /= 2
+		keep.insulin += h.insulin + offset
+	}
+	if h.glucagon > 1 {
+		offset := offset(h.glucagon)
+		h.glucagon /= 2
+		keep.glucagon += h.glucagon + offset
+	}
+	if h.interleukin_10 > 1 {
+		offset := offset(h.interleukin_10)
+		h.interleukin_10 /= 2
+		keep.interleukin_10 += h.interleukin_10 + offset
+	}
 	return keep
 }
 
@@ -203,6 +247,7 @@ func (p *ResourcePool) Check() *ResourceBlob {
 		o2:       p.resources.o2,
 		glucose:  p.resources.glucose,
 		vitamins: p.resources.vitamins,
+		glycogen: p.resources.glycogen,
 	}
 }
 
@@ -232,11 +277,15 @@ func (p *ResourcePool) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			panic("ResourcePool canceled")
+			return
 		case r := <-p.resourceChan:
 			p.resources.Add(r)
 		case <-p.wantChan:
-			p.resourceChan <- p.resources.Split()
+			select {
+			case <-ctx.Done():
+				return
+			case p.resourceChan <- p.resources.Split():
+			}
 		}
 	}
 }
@@ -274,11 +323,15 @@ func (p *WastePool) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			panic("WastePool canceled")
+			return
 		case r := <-p.wasteChan:
 			p.wastes.Add(r)
 		case <-p.wantChan:
-			p.wasteChan <- p.wastes.Split()
+			select {
+			case <-ctx.Done():
+				return
+			case p.wasteChan <- p.wastes.Split():
+			}
 		}
 	}
 }
@@ -316,12 +369,15 @@ func (p *LigandPool) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			panic("LigandPool canceled")
+			return
 		case r := <-p.ligandChan:
 			p.ligands.Add(r)
-		default:
-			<-p.wantChan
-			p.ligandChan <- p.ligands.Split()
+		case <-p.wantChan:
+			select {
+			case <-ctx.Done():
+				return
+			case p.ligandChan <- p.ligands.Split():
+			}
 		}
 	}
 }
@@ -359,12 +415,15 @@ func (p *HormonePool) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			panic("HormonePool")
+			return
 		case r := <-p.hormoneChan:
 			p.hormones.Add(r)
-		default:
-			<-p.wantChan
-			p.hormoneChan <- p.hormones.Split()
+		case <-p.wantChan:
+			select {
+			case <-ctx.Done():
+				return
+			case p.hormoneChan <- p.hormones.Split():
+			}
 		}
 	}
 }
@@ -379,40 +438,27 @@ type MaterialPool struct {
 func InitializeMaterialPool(ctx context.Context) *MaterialPool {
 	m := &MaterialPool{
 		resourcePool: &ResourcePool{
-			resources: &ResourceBlob{
-				o2:       SEED_O2,
-				glucose:  SEED_GLUCOSE,
-				vitamins: SEED_VITAMINS,
-			},
+			resources:    &ResourceBlob{},
 			resourceChan: make(chan *ResourceBlob, POOL_SIZE),
 			wantChan:     make(chan struct{}, POOL_SIZE),
 		},
 		wastePool: &WastePool{
-			wastes: &WasteBlob{
-				co2:        0,
-				creatinine: 0,
-			},
+			wastes:    &WasteBlob{},
 			wasteChan: make(chan *WasteBlob, POOL_SIZE),
 			wantChan:  make(chan struct{}, POOL_SIZE),
 		},
 		ligandPool: &LigandPool{
-			ligands: &LigandBlob{
-				growth: SEED_GROWTH,
-			},
+			ligands:    &LigandBlob{},
 			ligandChan: make(chan *LigandBlob, POOL_SIZE),
 			wantChan:   make(chan struct{}, POOL_SIZE),
 		},
 		hormonePool: &HormonePool{
-			hormones: &HormoneBlob{
-				granulocyte_csf: SEED_GRANULOCYTE_COLONY_STIMULATING_FACTOR,
-				macrophage_csf:  SEED_MACROPHAGE_COLONY_STIMULATING_FACTOR,
-				interleukin_3:   SEED_INTERLEUKIN_3,
-				interleukin_2:   SEED_INTERLEUKIN_2,
-			},
+			hormones:    &HormoneBlob{},
 			hormoneChan: make(chan *HormoneBlob, POOL_SIZE),
 			wantChan:    make(chan struct{}, POOL_SIZE),
 		},
 	}
+	m.Seed(DefaultMaterialSeed())
 	go m.resourcePool.Start(ctx)
 	go m.wastePool.Start(ctx)
 	go m.ligandPool.Start(ctx)
@@ -420,6 +466,69 @@ func InitializeMaterialPool(ctx context.Context) *MaterialPool {
 	return m
 }
 
+// Seed overwrites the current levels of every pool.
+func (m *MaterialPool) Seed(seed *MaterialSeed) {
+	m.resourcePool.Lock()
+	m.resourcePool.resources = &ResourceBlob{
+		o2:       seed.O2,
+		glucose:  seed.Glucose,
+		vitamins: seed.Vitamins,
+		glycogen: seed.Glycogen,
+	}
+	m.resourcePool.Unlock()
+	m.wastePool.Lock()
+	m.wastePool.wastes = &WasteBlob{
+		co2:        seed.CO2,
+		creatinine: seed.Creatinine,
+		ammonia:    seed.Ammonia,
+	}
+	m.wastePool.Unlock()
+	m.ligandPool.Lock()
+	m.ligandPool.ligands = &LigandBlob{
+		growth:       seed.Growth,
+		hunger:       seed.Hunger,
+		asphyxia:     seed.Asphyxia,
+		inflammation: seed.Inflammation,
+	}
+	m.ligandPool.Unlock()
+	m.hormonePool.Lock()
+	m.hormonePool.hormones = &HormoneBlob{
+		granulocyte_csf: seed.GranulocyteCSF,
+		macrophage_csf:  seed.MacrophageCSF,
+		interleukin_3:   seed.Interleukin3,
+		interleukin_2:   seed.Interleukin2,
+		complement:      seed.Complement,
+		insulin:         seed.Insulin,
+		glucagon:        seed.Glucagon,
+		interleukin_10:  seed.Interleukin10,
+	}
+	m.hormonePool.Unlock()
+}
+
+// BindInsulin uses up insulin for a cell to take up glucose with, if there is
+// any.
+func (m *MaterialPool) BindInsulin(ctx context.Context) bool {
+	hormone := m.GetHormone(ctx)
+	defer m.PutHormone(hormone)
+	if hormone.insulin < INSULIN_UPTAKE {
+		return false
+	}
+	hormone.insulin -= INSULIN_UPTAKE
+	return true
+}
+
+// FixComplement binds up to amount of complement to a surface, and returns
+// how much was bound.
+func (m *MaterialPool) FixComplement(ctx context.Context, amount int) int {
+	hormone := m.GetHormone(ctx)
+	defer m.PutHormone(hormone)
+	if hormone.complement < amount {
+		amount = hormone.complement
+	}
+	hormone.complement -= amount
+	return amount
+}
+
 func (m *MaterialPool) GetResource(ctx context.Context) *ResourceBlob {
 	return m.resourcePool.Get(ctx)
 }
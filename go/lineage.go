@@ -0,0 +1,293 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Lineage follows a cell through transport. A cell keeps its ID for life,
+// even though it gets a new render ID in every node, and remembers the ID of
+// the cell it divided or differentiated from.
+type Lineage struct {
+	ID     string
+	Parent string
+}
+
+func MakeLineageId(random *rand.Rand) string {
+	return fmt.Sprintf("%016x", random.Uint64())
+}
+
+type LineageRecord struct {
+	ID        string         `json:"id"`
+	Parent    string         `json:"parent,omitempty"`
+	CellType  CellType       `json:"-"`
+	Type      string         `json:"cellType"`
+	Born      time.Time      `json:"born"`
+	BornIn    string         `json:"bornIn"`
+	Node      string         `json:"node"` // Where the cell was last seen.
+	RenderId  RenderID       `json:"renderId"`
+	Died      *time.Time     `json:"died,omitempty"`
+	Cause     ApoptosisCause `json:"cause,omitempty"`
+	Kills     int            `json:"kills,omitempty"`
+	KilledBy  string         `json:"killedBy,omitempty"`
+	Infected  string         `json:"infected,omitempty"` // The virus that infected the cell.
+	Children  []string       `json:"children,omitempty"`
+	renderIds []RenderID     // Every render ID the cell can still be found by.
+}
+
+// LineageRegistry keeps a record of every living cell in the body, and of the
+// cells that died most recently, keyed by lineage ID.
+type LineageRegistry struct {
+	sync.RWMutex
+	records map[string]*LineageRecord
+	renders map[RenderID]string
+	dead    []string // Lineage IDs in the order the cells died.
+}
+
+func InitializeLineageRegistry() *LineageRegistry {
+	return &LineageRegistry{
+		records: map[string]*LineageRecord{},
+		renders: map[RenderID]string{},
+	}
+}
+
+// Arrive records a cell entering a node, either newly born or transported.
+func (r *LineageRegistry) Arrive(cell CellActor, node *Node) {
+	if r == nil {
+		return
+	}
+	lineage := cell.Lineage()
+	r.Lock()
+	defer r.Unlock()
+	record, ok := r.records[lineage.ID]
+	if !ok {
+		record = &LineageRecord{
+			ID:       lineage.ID,
+			Parent:   lineage.Parent,
+			CellType: cell.CellType(),
+			Type:     cell.CellType().String(),
+			Born:     cell.SpawnTime(),
+			BornIn:   node.name,
+		}
+		r.records[lineage.ID] = record
+		if parent, ok := r.records[lineage.Parent]; ok {
+			parent.Children = append(parent.Children, lineage.ID)
+		}
+	}
+	record.Node = node.name
+	record.RenderId = cell.Render().id
+	r.renders[record.RenderId] = lineage.ID
+	record.renderIds = append(record.renderIds, record.RenderId)
+	if len(record.renderIds) > LINEAGE_MAX_RENDER_IDS {
+		delete(r.renders, record.renderIds[0])
+		record.renderIds = record.renderIds[1:]
+	}
+}
+
+func (r *LineageRegistry) Die(id string, now time.Time, cause ApoptosisCause) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if record, ok := r.records[id]; ok && record.Died == nil {
+		record.Died = &now
+		record.Cause = cause
+		r.dead = append(r.dead, id)
+		r.forget()
+	}
+}
+
+// forget drops the records of the longest dead cells past LINEAGE_MAX_DEAD,
+// along with the render IDs they could be found by. Must be called with the
+// lock held.
+func (r *LineageRegistry) forget() {
+	for len(r.dead) > LINEAGE_MAX_DEAD {
+		record, ok := r.records[r.dead[0]]
+		r.dead = r.dead[1:]
+		if !ok {
+			continue
+		}
+		for _, renderId := range record.renderIds {
+			if r.renders[renderId] == record.ID {
+				delete(r.renders, renderId)
+			}
+		}
+		delete(r.records, record.ID)
+		if parent, ok := r.records[record.Parent]; ok {
+			// Copies of the parent's record may still share the old slice.
+			var children []string
+			for _, child := range parent.Children {
+				if child != record.ID {
+					children = append(children, child)
+				}
+			}
+			parent.Children = children
+		}
+	}
+}
+
+func (r *LineageRegistry) Kill(killer, victim string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if record, ok := r.records[killer]; ok {
+		record.Kills++
+	}
+	if record, ok := r.records[victim]; ok {
+		record.KilledBy = killer
+	}
+}
+
+func (r *LineageRegistry) Infect(id string, virus string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if record, ok := r.records[id]; ok {
+		record.Infected = virus
+	}
+}
+
+// Find looks up a cell by its lineage ID or any render ID it has had.
+func (r *LineageRegistry) Find(id string) (LineageRecord, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	if lineageId, ok := r.renders[RenderID(id)]; ok {
+		id = lineageId
+	}
+	record, ok := r.records[id]
+	if !ok {
+		return LineageRecord{}, false
+	}
+	return *record, true
+}
+
+type LineageTree struct {
+	LineageRecord
+	Descendants []*LineageTree `json:"descendants,omitempty"`
+}
+
+type FamilyTree struct {
+	Ancestors []LineageRecord `json:"ancestors"` // From the eldest down to the parent.
+	Cell      *LineageTree    `json:"cell"`
+}
+
+func (r *LineageRegistry) FamilyTree(id string) (*FamilyTree, error) {
+	record, ok := r.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("lineage: unknown cell %q", id)
+	}
+	r.RLock()
+	defer r.RUnlock()
+	tree := &FamilyTree{
+		Cell: r.descendants(record),
+	}
+	seen := map[string]bool{record.ID: true}
+	for parent, ok := r.records[record.Parent]; ok && !seen[parent.ID]; parent, ok = r.records[parent.Parent] {
+		seen[parent.ID] = true
+		tree.Ancestors = append([]LineageRecord{*parent}, tree.Ancestors...)
+	}
+	return tree, nil
+}
+
+func (r *LineageRegistry) descendants(record LineageRecord) *LineageTree {
+	tree := &LineageTree{LineageRecord: record}
+	for _, child := range record.Children {
+		if childRecord, ok := r.records[child]; ok {
+			tree.Descendants = append(tree.Descendants, r.descendants(*childRecord))
+		}
+	}
+	return tree
+}
+
+func (r *LineageRegistry) Snapshot() (lineage []*LineageSnapshot) {
+	r.RLock()
+	defer r.RUnlock()
+	for _, record := range r.records {
+		snapshot := &LineageSnapshot{
+			Id:       record.ID,
+			Parent:   record.Parent,
+			CellType: record.CellType,
+			Born:     record.Born.UnixNano(),
+			BornIn:   record.BornIn,
+			Node:     record.Node,
+			RenderId: string(record.RenderId),
+			Cause:    string(record.Cause),
+			Kills:    int32(record.Kills),
+			KilledBy: record.KilledBy,
+			Infected: record.Infected,
+			Children: record.Children,
+		}
+		if record.Died != nil {
+			snapshot.Died = record.Died.UnixNano()
+		}
+		lineage = append(lineage, snapshot)
+	}
+	return
+}
+
+func (r *LineageRegistry) Restore(lineage []*LineageSnapshot) {
+	r.Lock()
+	defer r.Unlock()
+	for _, snapshot := range lineage {
+		record := &LineageRecord{
+			ID:       snapshot.Id,
+			Parent:   snapshot.Parent,
+			CellType: snapshot.CellType,
+			Type:     snapshot.CellType.String(),
+			Born:     time.Unix(0, snapshot.Born),
+			BornIn:   snapshot.BornIn,
+			Node:     snapshot.Node,
+			RenderId: RenderID(snapshot.RenderId),
+			Cause:    ApoptosisCause(snapshot.Cause),
+			Kills:    int(snapshot.Kills),
+			KilledBy: snapshot.KilledBy,
+			Infected: snapshot.Infected,
+			Children: snapshot.Children,
+		}
+		if snapshot.Died != 0 {
+			died := time.Unix(0, snapshot.Died)
+			record.Died = &died
+			r.dead = append(r.dead, record.ID)
+		}
+		if record.RenderId != "" {
+			record.renderIds = []RenderID{record.RenderId}
+			r.renders[record.RenderId] = record.ID
+		}
+		r.records[record.ID] = record
+	}
+	sort.SliceStable(r.dead, func(i, j int) bool {
+		return r.records[r.dead[i]].Died.Before(*r.records[r.dead[j]].Died)
+	})
+	r.forget()
+}
+
+// HandleLineageRequest returns the family tree of a cell, given its lineage
+// ID or render ID, e.g. /admin/lineage?cell=KillerTLymphocyte01234567.
+func (r *LineageRegistry) HandleLineageRequest(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "Lineage must be requested with GET.", http.StatusMethodNotAllowed)
+		return
+	}
+	id := req.URL.Query().Get("cell")
+	if id == "" {
+		http.Error(w, "Missing cell query parameter.", http.StatusBadRequest)
+		return
+	}
+	tree, err := r.FamilyTree(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tree)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLineageFamilyTree(t *testing.T) {
+	dna := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	bone, blood := &Node{name: "Bone"}, &Node{name: "Blood"}
+	registry := InitializeLineageRegistry()
+	arrive := func(cellType CellType, lineage Lineage, node *Node) CellActor {
+		cell := MakeCellFromType(cellType, WorkType_nothing, dna, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+		cell.SetLineage(lineage)
+		registry.Arrive(cell, node)
+		return cell
+	}
+	arrive(CellType_Hemocytoblast, Lineage{ID: "hemocytoblast"}, bone)
+	arrive(CellType_Myeloblast, Lineage{ID: "myeloblast", Parent: "hemocytoblast"}, bone)
+	neutrophil := arrive(CellType_Neutrocyte, Lineage{ID: "neutrophil", Parent: "myeloblast"}, bone)
+	// Transport keeps the lineage, but not the render ID.
+	arrive(CellType_Neutrocyte, Lineage{ID: "neutrophil", Parent: "myeloblast"}, blood)
+	arrive(CellType_Bacteria, Lineage{ID: "bacteria"}, blood)
+	registry.Kill("neutrophil", "bacteria")
+	registry.Die("bacteria", time.Unix(2000, 0), PHAGOCYTOSIS_CAUSE)
+
+	tree, err := registry.FamilyTree(string(neutrophil.Render().id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Ancestors) != 2 || tree.Ancestors[0].ID != "hemocytoblast" || tree.Ancestors[1].ID != "myeloblast" {
+		t.Errorf("got ancestors %v, want the hemocytoblast then the myeloblast", tree.Ancestors)
+	}
+	if tree.Cell.ID != "neutrophil" || tree.Cell.Node != "Blood" || tree.Cell.BornIn != "Bone" || tree.Cell.Kills != 1 {
+		t.Errorf("got %+v, want the neutrophil born in bone with a kill in blood", tree.Cell.LineageRecord)
+	}
+	bacteria, _ := registry.Find("bacteria")
+	if bacteria.KilledBy != "neutrophil" || bacteria.Cause != PHAGOCYTOSIS_CAUSE || bacteria.Died == nil {
+		t.Errorf("got %+v, want the bacteria killed by the neutrophil", bacteria)
+	}
+
+	// The family tree survives a snapshot.
+	restored := InitializeLineageRegistry()
+	restored.Restore(registry.Snapshot())
+	root, err := restored.FamilyTree("hemocytoblast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(root.Cell.Descendants) != 1 || len(root.Cell.Descendants[0].Descendants) != 1 || root.Cell.Descendants[0].Descendants[0].ID != "neutrophil" {
+		t.Errorf("got descendants %v, want the myeloblast then the neutrophil", root.Cell.Descendants)
+	}
+	if _, err := restored.FamilyTree("unknown"); err == nil {
+		t.Error("expected an error for an unknown cell")
+	}
+}
+
+func TestLineageSurvivesTransport(t *testing.T) {
+	body, err := RestoreBody(context.Background(), &Snapshot{
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Nodes: []*NodeSnapshot{
+			{Name: "Lineage Heart", Organ: "heart"},
+			{Name: "Lineage Lung", Organ: "lung"},
+		},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dna := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	heart, lung := body.FindNode("Lineage Heart"), body.FindNode("Lineage Lung")
+	err = heart.MakeTransportRequest(heart.transportUrl, HUMAN_NAME, dna, CellType_Neutrocyte, WorkType_nothing, "", body.clock.Now(), [10]string{}, [10]string{}, nil, Lineage{Parent: "myeloblast"}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cells := heart.Cells()
+	if len(cells) != 1 {
+		t.Fatalf("got %v cells in the heart, want 1", len(cells))
+	}
+	lineage := cells[0].Lineage()
+	if lineage.ID == "" || lineage.Parent != "myeloblast" {
+		t.Fatalf("got lineage %+v, want a new ID with the myeloblast parent", lineage)
+	}
+	err = lung.MakeTransportRequest(lung.transportUrl, HUMAN_NAME, dna, CellType_Neutrocyte, WorkType_nothing, string(cells[0].Render().id), body.clock.Now(), [10]string{}, [10]string{}, nil, lineage, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, ok := body.lineage.Find(lineage.ID)
+	if !ok || record.Node != "Lineage Lung" || record.BornIn != "Lineage Heart" {
+		t.Errorf("got %+v, want the cell born in the heart and now in the lung", record)
+	}
+}
+
+func TestLineageForgetsTheLongDead(t *testing.T) {
+	dna := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	blood := &Node{name: "Blood"}
+	registry := InitializeLineageRegistry()
+	cell := MakeCellFromType(CellType_RedBlood, WorkType_nothing, dna, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	arrive := func(id, renderId string) {
+		cell.SetLineage(Lineage{ID: id, Parent: "hemocytoblast"})
+		cell.Render().id = RenderID(renderId)
+		registry.Arrive(cell, blood)
+	}
+	cell.SetLineage(Lineage{ID: "hemocytoblast"})
+	registry.Arrive(cell, blood)
+
+	// A cell that circulates keeps only its latest render IDs.
+	for i := 0; i < LINEAGE_MAX_RENDER_IDS+1; i++ {
+		arrive("circulating", fmt.Sprint("circulating-render-", i))
+	}
+	if _, ok := registry.Find("circulating-render-0"); ok {
+		t.Errorf("Expected the oldest render ID to be forgotten")
+	}
+	if record, ok := registry.Find(fmt.Sprint("circulating-render-", LINEAGE_MAX_RENDER_IDS)); !ok || record.ID != "circulating" {
+		t.Errorf("Expected the latest render ID to find the cell, got: %+v", record)
+	}
+
+	for i := 0; i < LINEAGE_MAX_DEAD+1; i++ {
+		id := fmt.Sprint("dead-", i)
+		arrive(id, id+"-render")
+		registry.Die(id, time.Unix(int64(2000+i), 0), LIFESPAN_CAUSE)
+	}
+	if _, ok := registry.Find("dead-0"); ok {
+		t.Errorf("Expected the longest dead cell to be forgotten")
+	}
+	if _, ok := registry.Find("dead-0-render"); ok {
+		t.Errorf("Expected the longest dead cell's render ID to be forgotten")
+	}
+	if _, ok := registry.Find("dead-1"); !ok {
+		t.Errorf("Expected the other dead cells to be remembered")
+	}
+	if _, ok := registry.Find("circulating"); !ok {
+		t.Errorf("Expected a living cell never to be forgotten")
+	}
+	stem, _ := registry.Find("hemocytoblast")
+	if len(stem.Children) != LINEAGE_MAX_DEAD+1 {
+		t.Errorf("Expected the forgotten child to be dropped, got %v children", len(stem.Children))
+	}
+
+	// A restored registry forgets in the order the cells died.
+	registry.Die("circulating", time.Unix(1000, 0), LIFESPAN_CAUSE)
+	restored := InitializeLineageRegistry()
+	restored.Restore(registry.Snapshot())
+	restored.Die("hemocytoblast", time.Unix(100000, 0), LIFESPAN_CAUSE)
+	if _, ok := restored.Find("circulating"); ok {
+		t.Errorf("Expected the earliest death to be forgotten on restore")
+	}
+	if _, ok := restored.Find("dead-2"); !ok {
+		t.Errorf("Expected the later deaths to be restored")
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Metrics collects samples in the Prometheus text format. Samples are grouped
+// by metric name, since each metric's samples must be written together.
+type Metrics struct {
+	names    []string
+	families map[string]*MetricFamily
+}
+
+type MetricFamily struct {
+	help    string
+	kind    string // counter or gauge
+	samples []string
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func (m *Metrics) Add(name, kind, help string, value float64, labels ...string) {
+	if m.families == nil {
+		m.families = map[string]*MetricFamily{}
+	}
+	family, ok := m.families[name]
+	if !ok {
+		family = &MetricFamily{
+			help: help,
+			kind: kind,
+		}
+		m.families[name] = family
+		m.names = append(m.names, name)
+	}
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%v="%v"`, labels[i], labelEscaper.Replace(labels[i+1])))
+	}
+	sample := name
+	if len(pairs) > 0 {
+		sample += "{" + strings.Join(pairs, ",") + "}"
+	}
+	family.samples = append(family.samples, fmt.Sprintf("%v %v", sample, value))
+}
+
+func (m *Metrics) Counter(name, help string, value float64, labels ...string) {
+	m.Add(name, "counter", help, value, labels...)
+}
+
+func (m *Metrics) Gauge(name, help string, value float64, labels ...string) {
+	m.Add(name, "gauge", help, value, labels...)
+}
+
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, name := range m.names {
+		family := m.families[name]
+		n, err := fmt.Fprintf(w, "# HELP %v %v\n# TYPE %v %v\n%v\n", name, family.help, name, family.kind, strings.Join(family.samples, "\n"))
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Enum values in a stable order, so every scrape lists the same series.
+func sortedEnumNames(names map[int32]string) (values []int32) {
+	for value := range names {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return
+}
+
+func (n *Node) CollectMetrics(m *Metrics, organ string, names map[string]string) {
+	node := []string{"node", n.name, "organ", organ}
+	labels := func(more ...string) []string {
+		return append(append([]string{}, node...), more...)
+	}
+
+	counts := n.GetWorkCounts()
+	var workTypes []WorkType
+	for workType := range counts {
+		workTypes = append(workTypes, workType)
+	}
+	sort.Slice(workTypes, func(i, j int) bool { return workTypes[i] < workTypes[j] })
+	for _, workType := range workTypes {
+		count := counts[workType]
+		work := labels("work_type", workType.String())
+		m.Counter("efflux_work_requests_total", "Work requested from the node.", float64(count.requestCount), work...)
+		m.Counter("efflux_work_successes_total", "Work requests that succeeded.", float64(count.successCount), work...)
+		m.Counter("efflux_work_failures_total", "Work requests that failed.", float64(count.failureCount), work...)
+		m.Counter("efflux_work_completed_total", "Work completed by cells in the node.", float64(count.completedCount), work...)
+		m.Counter("efflux_work_completed_failures_total", "Work that cells in the node failed to complete.", float64(count.completedFailureCount), work...)
+	}
+
+	materials := n.GetMaterialStatus().ProtoReflect()
+	fields := materials.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.Int32Kind {
+			continue
+		}
+		name := fmt.Sprintf("efflux_material_%v", field.Name())
+		m.Gauge(name, fmt.Sprintf("Amount of %v in the node.", field.Name()), float64(materials.Get(field).Int()), labels()...)
+	}
+
+	cellCounts := map[CellType]int{}
+	for _, cell := range n.Cells() {
+		cellCounts[cell.CellType()]++
+	}
+	for _, cellType := range sortedEnumNames(CellType_name) {
+		if CellType(cellType) == CellType_ViralLoadCarrier {
+			continue
+		}
+		m.Gauge("efflux_cells", "Live cells in the node.", float64(cellCounts[CellType(cellType)]), labels("cell_type", CellType(cellType).String())...)
+	}
+
+	n.antigenPool.viralLoads.Range(func(_, v any) bool {
+		viralLoad := v.(*ViralLoad)
+		viralLoad.RLock()
+		m.Gauge("efflux_viral_load", "Concentration of each virus in the node.", float64(viralLoad.concentration),
+			labels("virus", viralLoad.virus.dna.name, "target_cell_type", viralLoad.virus.targetCellType.String())...)
+		viralLoad.RUnlock()
+		return true
+	})
+	// Each isotype has its own load, but they add up to the same protein.
+	var proteins []Protein
+	antibodies := map[Protein]int64{}
+	n.antigenPool.antibodyLoads.Range(func(_, l any) bool {
+		antibodyLoad := l.(*AntibodyLoad)
+		antibodyLoad.RLock()
+		if _, ok := antibodies[antibodyLoad.targetProtein]; !ok {
+			proteins = append(proteins, antibodyLoad.targetProtein)
+		}
+		antibodies[antibodyLoad.targetProtein] += antibodyLoad.concentration
+		m.Gauge("efflux_antibody_affinity", "Average affinity of the antibodies of each isotype for each protein in the node.", float64(antibodyLoad.affinity),
+			labels("protein", fmt.Sprint(antibodyLoad.targetProtein), "isotype", antibodyLoad.isotype.String())...)
+		antibodyLoad.RUnlock()
+		return true
+	})
+	for _, protein := range proteins {
+		m.Gauge("efflux_antibody_load", "Concentration of antibodies for each protein in the node.", float64(antibodies[protein]),
+			labels("protein", fmt.Sprint(protein))...)
+	}
+
+	cytokines := map[CytokineType]uint32{}
+	for _, cytokine := range n.tissue.SnapshotCytokines() {
+		cytokines[cytokine.CytokineType] += cytokine.Concentration
+	}
+	for _, cytokineType := range sortedEnumNames(CytokineType_name) {
+		if CytokineType(cytokineType) == CytokineType_unknown {
+			continue
+		}
+		m.Gauge("efflux_cytokine_concentration", "Total concentration of each cytokine in the node's tissue.", float64(cytokines[CytokineType(cytokineType)]),
+			labels("cytokine_type", CytokineType(cytokineType).String())...)
+	}
+
+	for _, edge := range n.Edges() {
+		status := edge.Status(n.name, names[edge.transportUrl])
+		edgeLabels := []string{"from", status.From, "to", status.To, "edge_type", status.EdgeType.String()}
+		m.Counter("efflux_transports_total", "Cells and viral loads transported along each edge.", float64(status.Transports), edgeLabels...)
+		healthy := 0.0
+		if status.Healthy {
+			healthy = 1
+		}
+		m.Gauge("efflux_edge_healthy", "Whether each edge is connected.", healthy, edgeLabels...)
+		m.Gauge("efflux_edge_latency_seconds", "Round trip of the last heartbeat along each edge.", status.LatencyMs/1000, edgeLabels...)
+	}
+}
+
+func (b *Body) CollectMetrics() *Metrics {
+	m := &Metrics{}
+	m.Gauge("efflux_simulation_time_seconds", "Current time on the simulation clock.", float64(b.clock.Now().UnixNano())/1e9)
+	names := b.NodeNames()
+	for _, organ := range ORGANS {
+		for _, node := range *b.OrganNodes(organ) {
+			node.CollectMetrics(m, organ, names)
+		}
+	}
+	return m
+}
+
+func (b *Body) HandleMetricsRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Metrics must be requested with GET.", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	b.CollectMetrics().WriteTo(w)
+}
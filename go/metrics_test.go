@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	dnas := &DNAIndex{}
+	dnas.Add(MakeDNA(HUMAN_DNA, HUMAN_NAME))
+	body, err := RestoreBody(context.Background(), &Snapshot{
+		Seed:   11,
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Dna:    dnas.dna,
+		Nodes: []*NodeSnapshot{
+			{
+				Name:          "Metrics Heart",
+				Organ:         "heart",
+				Materials:     &MaterialStatusSocketData{O2: 12},
+				AntibodyLoads: []*AntibodyLoadSnapshot{{TargetProtein: 42, Concentration: 5, Affinity: 30}},
+				Cells: []*CellSnapshot{
+					{CellType: CellType_Cardiomyocyte, WorkType: WorkType_pump, SpawnTime: time.Unix(1000, 0).UnixNano()},
+					{CellType: CellType_Cardiomyocyte, WorkType: WorkType_pump, SpawnTime: time.Unix(1000, 0).UnixNano()},
+				},
+			},
+			{Name: "Metrics Lung", Organ: "lung"},
+		},
+		Edges: []*EdgeSnapshot{
+			{From: "Metrics Heart", To: "Metrics Lung", EdgeType: int32(cardiovascular)},
+		},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	heart := body.FindNode("Metrics Heart")
+	heart.managers.Store(WorkType_exhale, &WorkManager{
+		WorkCounts: WorkCounts{requestCount: 3, successCount: 2},
+	})
+
+	want := []string{
+		`efflux_work_requests_total{node="Metrics Heart",organ="heart",work_type="exhale"} 3`,
+		`efflux_work_successes_total{node="Metrics Heart",organ="heart",work_type="exhale"} 2`,
+		`efflux_material_o2{node="Metrics Heart",organ="heart"} 12`,
+		`efflux_cells{node="Metrics Heart",organ="heart",cell_type="Cardiomyocyte"} 2`,
+		`efflux_cells{node="Metrics Lung",organ="lung",cell_type="Cardiomyocyte"} 0`,
+		`efflux_antibody_load{node="Metrics Heart",organ="heart",protein="42"} 5`,
+		`efflux_antibody_affinity{node="Metrics Heart",organ="heart",protein="42",isotype="IgM"} 30`,
+		`efflux_transports_total{from="Metrics Heart",to="Metrics Lung",edge_type="cardiovascular"} 0`,
+		"# TYPE efflux_work_requests_total counter",
+		"# TYPE efflux_cells gauge",
+	}
+	// Scraping must not reset the counters.
+	for scrape := 0; scrape < 2; scrape++ {
+		recorder := httptest.NewRecorder()
+		body.HandleMetricsRequest(recorder, httptest.NewRequest("GET", METRICS_ENDPOINT, nil))
+		metrics := recorder.Body.String()
+		for _, line := range want {
+			if !strings.Contains(metrics, line+"\n") {
+				t.Errorf("scrape %v: missing %q", scrape, line)
+			}
+		}
+		if strings.Count(metrics, "# TYPE efflux_cells ") != 1 {
+			t.Errorf("scrape %v: expected efflux_cells to be declared once", scrape)
+		}
+	}
+}
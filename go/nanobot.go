@@ -214,6 +214,14 @@ func (n *Nanobot) ProcessInteraction(request *InteractionRequest, response *Inte
 		response.ErrorMessage = fmt.Sprint(err)
 	} else {
 		response.Status = InteractionResponse_success
+		if request.Type != InteractionType_ping && n.organ != nil {
+			n.organ.LogEvent(Event{
+				Type:   NANOBOT_EVENT,
+				CellId: string(n.render.id),
+				Target: request.TargetCell,
+				Detail: request.Type.String(),
+			})
+		}
 		if n.attachedTo != nil {
 			response.AttachedTo = string(n.attachedTo.id)
 		}
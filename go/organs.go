@@ -1,10 +1,10 @@
 package main
 
 import (
-	"bytes"
 	"container/ring"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"image"
 	"io"
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -33,19 +34,6 @@ type Work struct {
 	status   int
 }
 
-type TransportRequest struct {
-	Name            string
-	Base            []byte
-	DNAType         int // Curve number, like elliptic.P384()
-	CellType        CellType
-	WorkType        WorkType
-	ParentRenderID  string
-	SpawnTime       time.Time
-	TransportPath   [10]string
-	WantPath        [10]string
-	MHC_II_Proteins []Protein
-}
-
 type EdgeType int
 
 const (
@@ -56,15 +44,14 @@ const (
 	skeletal
 	gut_lining
 	blood_brain_barrier
+	thymic
+	splenic
 )
 
-type Edge struct {
-	edgeType       EdgeType
-	workConnection *Connection
-	transportUrl   string
-}
-
-func MakeTransportRequest(
+// MakeTransportRequest sends a new cell to the node at transportUrl. The seed
+// should be drawn from the sender's own stream, so that the new cell's stream
+// doesn't depend on the order in which nodes handle requests.
+func (n *Node) MakeTransportRequest(
 	transportUrl string,
 	name string,
 	dna *DNA,
@@ -75,70 +62,139 @@ func MakeTransportRequest(
 	transportPath [10]string,
 	wantPath [10]string,
 	mhc_ii map[Protein]bool,
+	lineage Lineage,
+	seed int64,
 ) error {
-	dnaBase, err := dna.Serialize()
-	if err != nil {
-		log.Fatal("Transport: ", err)
-	}
-	dnaType := 0
-	for i, d := range DNATypeMap {
-		if d == dna.dnaType {
-			dnaType = i
-		}
+	return n.transport.SendCell(transportUrl, MakeSpawnRequest(name, dna, cellType, workType, parentRenderID, spawnTime, transportPath, wantPath, mhc_ii, lineage, seed))
+}
+
+// MakeSpawnRequest describes a new cell, to be made by whichever node it is
+// sent to.
+func MakeSpawnRequest(
+	name string,
+	dna *DNA,
+	cellType CellType,
+	workType WorkType,
+	parentRenderID string,
+	spawnTime time.Time,
+	transportPath [10]string,
+	wantPath [10]string,
+	mhc_ii map[Protein]bool,
+	lineage Lineage,
+	seed int64,
+) *TransportRequest {
+	dnas := &DNAIndex{references: true}
+	cell := &CellSnapshot{
+		CellType:        cellType,
+		WorkType:        workType,
+		Dna:             dnas.Add(dna.Rename(name)),
+		SpawnTime:       spawnTime.UnixNano(),
+		TransportPath:   transportPath[:],
+		WantPath:        wantPath[:],
+		LineageId:       lineage.ID,
+		ParentLineageId: lineage.Parent,
 	}
-	var mhc_ii_proteins []Protein
 	for protein := range mhc_ii {
-		mhc_ii_proteins = append(mhc_ii_proteins, protein)
+		cell.Proteins = append(cell.Proteins, uint32(protein))
 	}
-	jsonData, err := json.Marshal(TransportRequest{
-		Name:            name,
-		Base:            dnaBase,
-		DNAType:         dnaType,
-		CellType:        cellType,
-		WorkType:        workType,
-		ParentRenderID:  parentRenderID,
-		SpawnTime:       spawnTime,
-		TransportPath:   transportPath,
-		WantPath:        wantPath,
-		MHC_II_Proteins: mhc_ii_proteins,
+	return &TransportRequest{
+		Cell:           cell,
+		Dna:            dnas.dna,
+		ParentRenderId: parentRenderID,
+		Seed:           seed,
+	}
+}
+
+// TransportCell sends a cell to the node at transportUrl with all of its
+// state, like its damage, viral load and place in its state diagram. Where it
+// was rendered has no meaning in the other node.
+func (n *Node) TransportCell(transportUrl string, c CellActor) error {
+	dnas := &DNAIndex{references: true}
+	cell := c.Snapshot(dnas)
+	cell.RenderId = ""
+	cell.Position = nil
+	cell.Target = nil
+	cell.FollowId = ""
+	cell.CellActions = append(cell.CellActions, CellActionStatus_transport)
+	return n.transport.SendCell(transportUrl, &TransportRequest{
+		Cell:           cell,
+		Dna:            dnas.dna,
+		ParentRenderId: string(c.Render().id),
+		Seed:           c.Rand().Int63(),
 	})
+}
+
+func (n *Node) HandleTransportRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		return fmt.Errorf("transport error: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	request, err := http.NewRequest("POST", transportUrl, bytes.NewBuffer(jsonData))
+	request := &TransportRequest{}
+	err = proto.Unmarshal(data, request)
 	if err != nil {
-		return fmt.Errorf("transport error: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	request.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
-	client := &http.Client{}
-	response, err := client.Do(request)
-	if err != nil {
-		return fmt.Errorf("transport error: %v", err)
+	cell, err := n.ReceiveTransportRequest(ctx, request)
+	var requestError *RequestError
+	var unknownDNAError *UnknownDNAError
+	if errors.As(err, &unknownDNAError) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if errors.As(err, &requestError) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-	defer response.Body.Close()
-	return nil
+	fmt.Fprintf(w, "Success: Created %s", cell)
 }
 
-func (n *Node) HandleTransportRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	d := json.NewDecoder(r.Body)
-	d.DisallowUnknownFields() // catch unwanted fields
-
-	request := TransportRequest{}
-
-	err := d.Decode(&request)
+func (n *Node) HandleControlRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Control requests must be POSTed.", http.StatusMethodNotAllowed)
+		return
+	}
+	request := &ControlRequest{}
+	err := json.NewDecoder(r.Body).Decode(request)
 	if err != nil {
-		// bad JSON or unrecognized json field
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-
-	cell, err := n.MakeCellFromRequest(request)
+	err = n.Control(ctx, request)
 	if err != nil {
-		log.Fatal(err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	fmt.Fprintf(w, "Success: Controlled %v", n)
+}
+
+// Control applies an admin request forwarded from another process to the body
+// hosting the node.
+func (n *Node) Control(ctx context.Context, request *ControlRequest) error {
+	if n.control == nil {
+		return fmt.Errorf("%v is not part of a body", n)
+	}
+	return n.control(ctx, request)
+}
+
+// ReceiveTransportRequest spawns a cell that arrived in the node, or deposits
+// its viral load.
+func (n *Node) ReceiveTransportRequest(ctx context.Context, request *TransportRequest) (CellActor, error) {
+	dnas, err := ResolveDNA(request.Dna)
+	var unknownDNAError *UnknownDNAError
+	if errors.As(err, &unknownDNAError) {
+		return nil, err
+	} else if err != nil {
+		return nil, &RequestError{Err: err}
+	}
+	cell, err := n.MakeCellFromRequest(request, dnas)
+	if err != nil {
+		return nil, err
+	}
 	if cell.CellType() == CellType_ViralLoadCarrier {
 		virusCarrier := cell.(*VirusCarrier)
 		n.antigenPool.DepositViralLoad(&ViralLoad{
@@ -150,26 +206,46 @@ func (n *Node) HandleTransportRequest(ctx context.Context, w http.ResponseWriter
 		}
 	} else {
 		cell.SetOrgan(n)
+		n.AddCell(cell)
 		cell.RecordTransport()
+		n.lineage.Arrive(cell, n)
+		cell.LogEvent(Event{Type: SPAWN_EVENT})
 		if n.verbose {
 			fmt.Println("Spawned:", cell, "in", cell.Organ())
 		}
+		if request.Cell.LineageId != "" {
+			ResumeCell(cell, request.Cell, dnas)
+		}
 		ctx, stop := context.WithCancel(ctx)
 		cell.SetStop(stop)
 		cell.Start(ctx)
 	}
-
-	fmt.Fprintf(w, "Success: Created %s", cell)
+	return cell, nil
 }
 
-func (n *Node) MakeCellFromRequest(request TransportRequest) (CellActor, error) {
-	dna, err := MakeDNAFromRequest(request)
-	if err != nil {
-		return nil, err
+func (n *Node) MakeCellFromRequest(request *TransportRequest, dnas []*DNA) (CellActor, error) {
+	snapshot := request.Cell
+	if snapshot == nil {
+		return nil, &RequestError{Err: fmt.Errorf("no cell")}
+	}
+	if _, err := ParseCellType(snapshot.CellType.String()); err != nil {
+		return nil, &RequestError{Err: err}
+	}
+	if snapshot.Dna < 0 || int(snapshot.Dna) >= len(dnas) {
+		return nil, &RequestError{Err: fmt.Errorf("unknown DNA: %v", snapshot.Dna)}
+	}
+	if snapshot.ViralLoad != nil && (snapshot.ViralLoad.Dna < 0 || int(snapshot.ViralLoad.Dna) >= len(dnas)) {
+		return nil, &RequestError{Err: fmt.Errorf("viral load with unknown DNA: %v", snapshot.ViralLoad.Dna)}
 	}
+	seed := request.Seed
+	if seed == 0 {
+		// Sent without a seed, so the order requests arrive in decides it.
+		seed = n.rand.Int63()
+	}
+	random := MakeLockedRand(seed)
 	var render *Renderable
-	if request.ParentRenderID != "" {
-		renderId := RenderID(request.ParentRenderID)
+	if request.ParentRenderId != "" {
+		renderId := RenderID(request.ParentRenderId)
 		if n.tissue != nil {
 			render = n.tissue.FindRender(renderId)
 		}
@@ -177,13 +253,34 @@ func (n *Node) MakeCellFromRequest(request TransportRequest) (CellActor, error)
 	if render == nil {
 		// Place cell somewhere random.
 		render = &Renderable{
-			position: image.Point{RandInRange(-MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS), RandInRange(-MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS)},
+			position: image.Point{RandInRange(random, -MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS), RandInRange(random, -MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS)},
 		}
 	}
-	return MakeCellFromType(request.CellType, request.WorkType, dna, render, request.SpawnTime, request.TransportPath, request.WantPath, request.MHC_II_Proteins), nil
+	var transportPath, wantPath [10]string
+	copy(transportPath[:], snapshot.TransportPath)
+	copy(wantPath[:], snapshot.WantPath)
+	var proteins []Protein
+	for _, p := range snapshot.Proteins {
+		proteins = append(proteins, Protein(p))
+	}
+	cell := MakeCellFromType(snapshot.CellType, snapshot.WorkType, dnas[snapshot.Dna], render, time.Unix(0, snapshot.SpawnTime), transportPath, wantPath, proteins, random)
+	if snapshot.LineageId == "" {
+		// A new cell, rather than one that was transported.
+		cell.SetLineage(Lineage{
+			ID:     MakeLineageId(random),
+			Parent: snapshot.ParentLineageId,
+		})
+		if p, ok := cell.(AntibodyProducing); ok {
+			// Makes the antibody of the B cell it came from.
+			p.Immunoglobulin().Restore(snapshot)
+		}
+	} else {
+		cell.Restore(snapshot)
+	}
+	return cell, nil
 }
 
-func SendWork(connection *Connection, request Work, diffusion *DiffusionSocketData) {
+func (c *Connection) SendWork(request Work, diffusion *DiffusionSocketData) error {
 	work := &WorkSocketData{
 		WorkType:  int32(request.workType),
 		Result:    request.result,
@@ -192,12 +289,13 @@ func SendWork(connection *Connection, request Work, diffusion *DiffusionSocketDa
 	}
 	out, err := proto.Marshal(work)
 	if err != nil {
-		log.Fatalln("Failed to encode work:", err)
+		return fmt.Errorf("failed to encode work: %w", err)
 	}
-	err = connection.WriteMessage(websocket.BinaryMessage, out)
+	err = c.WriteMessage(websocket.BinaryMessage, out)
 	if err != nil {
-		log.Fatal("Send: ", err)
+		return &ConnectionError{Op: "send", Address: c.Address(), Err: err}
 	}
+	return nil
 }
 
 func SendStatus(connection *Connection, status *StatusSocketData) error {
@@ -212,15 +310,17 @@ func SendStatus(connection *Connection, status *StatusSocketData) error {
 	return nil
 }
 
-func ReceiveWork(connection *Connection) (request Work, diffusion *DiffusionSocketData) {
-	_, message, err := connection.ReadMessage()
+func (c *Connection) ReceiveWork() (request Work, diffusion *DiffusionSocketData, err error) {
+	_, message, err := c.ReadMessage()
 	if err != nil {
-		log.Fatalln("Receive: ", err)
+		err = &ConnectionError{Op: "receive", Address: c.Address(), Err: err}
+		return
 	}
 	work := &WorkSocketData{}
 	err = proto.Unmarshal(message, work)
 	if err != nil {
-		log.Fatalln("Failed to parse work: ", err)
+		err = &ProtocolError{Address: c.Address(), Reason: "failed to parse work", Err: err}
+		return
 	}
 	request.workType = WorkType(work.WorkType)
 	request.status = int(work.Status)
@@ -229,10 +329,19 @@ func ReceiveWork(connection *Connection) (request Work, diffusion *DiffusionSock
 	return
 }
 
+func (c *Connection) Address() string {
+	return strings.Replace(c.RemoteAddr().String(), WORK_ENDPOINT, "", 1)
+}
+
 type WorkManager struct {
 	sync.RWMutex
-	nextAvailableWorker   chan Worker
-	resultChan            chan Work
+	nextAvailableWorker chan Worker
+	resultChan          chan Work
+	WorkCounts
+}
+
+// WorkCounts only ever go up, so they can be scraped by more than one reader.
+type WorkCounts struct {
 	requestCount          int
 	successCount          int
 	failureCount          int
@@ -243,6 +352,7 @@ type WorkManager struct {
 type Node struct {
 	sync.RWMutex
 	name           string
+	organ          string
 	edges          []*Edge
 	serverMux      *http.ServeMux
 	origin         string
@@ -250,47 +360,79 @@ type Node struct {
 	websocketUrl   string
 	transportUrl   string
 	managers       *sync.Map
+	cells          *sync.Map
 	nanobotManager *NanobotManager
 	materialPool   *MaterialPool
 	antigenPool    *AntigenPool
 	tissue         *Tissue
 	verbose        bool
+	rand           *rand.Rand // Seeds cells the node makes itself.
+	diffusionRand  *rand.Rand
+	exposureRand   *rand.Rand
+	clock          *Clock
+	events         *EventLog
+	lineage        *LineageRegistry
+	transport      Transporter
+	control        func(context.Context, *ControlRequest) error // Set by the body hosting the node.
 }
 
+var currentHost = "localhost"
 var currentPort = 7999
 
+// SetAddresses picks the host other processes reach this one's nodes at, and
+// the port of the first node. Must be called before the body is generated.
+func SetAddresses(host string, firstPort int) {
+	currentHost = host
+	currentPort = firstPort - 1
+}
+
 func GetNextAddresses() (string, string, string, string, string) {
 	currentPort++
-	return ORIGIN,
+	return fmt.Sprintf(ORIGIN_TEMPLATE, currentHost),
 		fmt.Sprintf(":%v", currentPort),
-		fmt.Sprintf(URL_TEMPLATE, currentPort),
-		fmt.Sprintf(WORK_URL_TEMPLATE, currentPort),
-		fmt.Sprintf(TRANSPORT_URL_TEMPLATE, currentPort)
+		fmt.Sprintf(URL_TEMPLATE, currentHost, currentPort),
+		fmt.Sprintf(WORK_URL_TEMPLATE, currentHost, currentPort),
+		fmt.Sprintf(TRANSPORT_URL_TEMPLATE, currentHost, currentPort)
 }
 
 func InitializeNewNode(ctx context.Context, graph *Graph, name string, verbose bool) *Node {
-	origin, port, url, websocketUrl, transportUrl := GetNextAddresses()
+	// Each goroutine that draws for the node has its own stream, so that none
+	// of them changes what the others draw.
+	stream := func(purpose string) *rand.Rand {
+		return MakeRand("node/" + name + "/" + purpose)
+	}
+	transport := graph.transport
+	if transport == nil {
+		transport = InitializeHTTPTransport()
+	}
 	node := &Node{
-		name:         name,
-		origin:       origin,
-		port:         port,
-		websocketUrl: websocketUrl,
-		transportUrl: transportUrl,
-		managers:     &sync.Map{},
-		tissue:       InitializeTissue(ctx),
-		verbose:      verbose,
+		name:          name,
+		managers:      &sync.Map{},
+		cells:         &sync.Map{},
+		tissue:        InitializeTissue(ctx, stream("tissue"), graph.clock),
+		verbose:       verbose,
+		rand:          stream("cells"),
+		diffusionRand: stream("diffusion"),
+		exposureRand:  stream("exposures"),
+		clock:         graph.clock,
+		events:        graph.events,
+		lineage:       graph.lineage,
+		transport:     transport,
 	}
 	node.materialPool = InitializeMaterialPool(ctx)
-	node.antigenPool = InitializeAntigenPool(ctx)
+	node.antigenPool = InitializeAntigenPool(ctx, stream("antigens"), graph.clock)
 	node.nanobotManager = InitializeNanobotManager(ctx)
-	graph.allNodes[url] = node
 	node.Start(ctx)
+	graph.allNodes[node.transportUrl] = node
 	go node.tissue.Start(ctx)
 	go node.nanobotManager.Start(ctx)
 	return node
 }
 
 func (n *Node) String() string {
+	if n.port == "" {
+		return fmt.Sprintf("%v (%v)", n.name, n.transportUrl)
+	}
 	return fmt.Sprintf("%v (%v%v)", n.name, n.origin[:len(n.origin)-1], n.port)
 }
 
@@ -338,32 +480,39 @@ func WebsocketHandler(ctx context.Context, connectionHandler func(context.Contex
 	return handler
 }
 
-func (n *Node) Start(ctx context.Context) {
-	n.serverMux = http.NewServeMux()
-	n.serverMux.HandleFunc(WORK_ENDPOINT, WebsocketHandler(ctx, n.ProcessIncomingWorkRequests))
-	n.serverMux.HandleFunc(STATUS_ENDPOINT, WebsocketHandler(ctx, n.GetNodeStatus))
-	n.serverMux.HandleFunc(TRANSPORT_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+func (n *Node) MakeServeMux(ctx context.Context) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(WORK_ENDPOINT, WebsocketHandler(ctx, func(ctx context.Context, connection *Connection) {
+		n.ProcessIncomingWorkRequests(ctx, connection)
+	}))
+	mux.HandleFunc(STATUS_ENDPOINT, WebsocketHandler(ctx, n.GetNodeStatus))
+	mux.HandleFunc(TRANSPORT_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
 		n.HandleTransportRequest(ctx, w, r)
 	})
-	n.serverMux.HandleFunc(INTERACTIONS_LOGIN_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc(CONTROL_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		n.HandleControlRequest(ctx, w, r)
+	})
+	mux.HandleFunc(INTERACTIONS_LOGIN_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
 		n.InteractionsLogin(ctx, w, r)
 	})
-	n.serverMux.HandleFunc(INTERACTIONS_STREAM_ENDPOINT, WebsocketHandler(ctx, n.InteractionStream))
+	mux.HandleFunc(INTERACTIONS_STREAM_ENDPOINT, WebsocketHandler(ctx, n.InteractionStream))
 	if n.tissue != nil {
-		n.serverMux.HandleFunc(WORLD_CELLS_RENDER_ENDPOINT, WebsocketHandler(ctx, n.tissue.StreamCells))
-		n.serverMux.HandleFunc(WORLD_CYTOKINE_RENDER_ENDPOINT, WebsocketHandler(ctx, n.tissue.StreamCytokines))
-		n.serverMux.HandleFunc(WORLD_TEXTURE_ENDPOINT, n.tissue.RenderRootMatrix)
+		mux.HandleFunc(WORLD_CELLS_RENDER_ENDPOINT, WebsocketHandler(ctx, n.tissue.StreamCells))
+		mux.HandleFunc(WORLD_CYTOKINE_RENDER_ENDPOINT, WebsocketHandler(ctx, n.tissue.StreamCytokines))
+		mux.HandleFunc(WORLD_TEXTURE_ENDPOINT, n.tissue.RenderRootMatrix)
 	}
+	return mux
+}
 
-	go func() {
-		err := http.ListenAndServe(n.port, n.serverMux)
-		if err != nil {
-			log.Fatal(fmt.Sprintf("%v ListenAndServe: ", n), err.Error())
-		}
-	}()
+func (n *Node) Start(ctx context.Context) {
+	err := n.transport.Listen(ctx, n)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	go func() {
-		ticker := time.NewTicker(DIFFUSION_SEC)
+		ticker := n.clock.NewTicker(ctx, DIFFUSION_SEC)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ticker.C:
@@ -403,7 +552,7 @@ func (n *Node) InteractionsLogin(ctx context.Context, w http.ResponseWriter, r *
 		name:         name,
 		sessionToken: token,
 		render: &Renderable{
-			id:            MakeRenderId("Nanobot"),
+			id:            MakeRenderId(unownedRand, "Nanobot"),
 			visible:       false,
 			position:      image.Point{},
 			targetX:       0,
@@ -496,29 +645,29 @@ func (n *Node) InteractionStream(ctx context.Context, connection *Connection) {
 	}
 }
 
-func (n *Node) Connect(ctx context.Context, origin, websocketUrl string, transportUrl string, edgeType EdgeType) error {
-	dialer := websocket.Dialer{}
-	connection, response, err := dialer.DialContext(ctx, websocketUrl, http.Header{})
-	if err != nil || response.StatusCode != 101 {
-		return fmt.Errorf("%v Connect: %w", n, err)
-	}
-	n.Lock()
-	defer n.Unlock()
-	conn := &Connection{
-		Conn: connection,
+func (n *Node) Connect(ctx context.Context, transportUrl string, edgeType EdgeType) error {
+	conn, err := n.transport.Dial(ctx, n, transportUrl)
+	if err != nil {
+		return err
 	}
-	n.edges = append(n.edges, &Edge{
+	edge := &Edge{
 		edgeType:       edgeType,
 		workConnection: conn,
 		transportUrl:   transportUrl,
-	})
-	go n.ProcessIncomingWorkResponses(ctx, conn)
+		healthy:        true,
+		lastSeen:       time.Now(),
+	}
+	n.Lock()
+	n.edges = append(n.edges, edge)
+	n.Unlock()
+	go n.MaintainEdge(ctx, edge)
+	go n.MonitorEdge(ctx, edge)
 	return nil
 }
 
 func ConnectNodes(ctx context.Context, node1, node2 *Node, toNode2 EdgeType, toNode1 EdgeType) {
-	node1.Connect(ctx, node2.origin, node2.websocketUrl, node2.transportUrl, toNode2)
-	node2.Connect(ctx, node1.origin, node1.websocketUrl, node1.transportUrl, toNode1)
+	node1.Connect(ctx, node2.transportUrl, toNode2)
+	node2.Connect(ctx, node1.transportUrl, toNode1)
 }
 
 func (n *Node) MakeAvailable(ctx context.Context, worker Worker) {
@@ -556,22 +705,64 @@ func (n *Node) AddWorker(worker Worker) {
 	}
 }
 
+func (n *Node) AddCell(cell CellActor) {
+	n.cells.Store(cell.Render().id, cell)
+}
+
+func (n *Node) RemoveCell(id RenderID) {
+	n.cells.Delete(id)
+}
+
+func (n *Node) Cells() (cells []CellActor) {
+	n.cells.Range(func(_, c any) bool {
+		cells = append(cells, c.(CellActor))
+		return true
+	})
+	return
+}
+
 func (n *Node) RemoveWorker(worker Worker) {
 	n.Lock()
 	defer n.Unlock()
 	worker.SetOrgan(nil)
 }
 
-func (n *Node) ProcessIncomingWorkRequests(ctx context.Context, connection *Connection) {
+func (n *Node) ProcessIncomingWorkRequests(ctx context.Context, connection WorkConnection) {
 	defer connection.Close()
 	for {
 		select {
 		case <-ctx.Done():
-			log.Fatalln("Node cannot process incoming work")
 			return
 		default:
-			work, diffusion := ReceiveWork(connection)
+			work, diffusion, err := connection.ReceiveWork()
+			var protocolError *ProtocolError
+			if errors.As(err, &protocolError) {
+				fmt.Println(n, err)
+				continue
+			} else if err != nil {
+				if n.verbose {
+					fmt.Println(n, "work connection closed:", err)
+				}
+				return
+			}
+			if work.workType == WorkType_nothing && work.status == 0 {
+				// A heartbeat, answered before any work waiting on workers.
+				work.status = 200
+				if err := connection.SendWork(work, nil); err != nil {
+					return
+				}
+				continue
+			}
 			if n.materialPool != nil && work.workType == WorkType_diffusion {
+				if diffusion == nil {
+					// A peer that sends diffusion without its materials can't be
+					// trusted with the rest of its frames.
+					fmt.Println(n, &ProtocolError{
+						Address: connection.Address(),
+						Reason:  "diffusion sent without a payload",
+					})
+					return
+				}
 				n.ReceiveDiffusion(diffusion)
 				continue
 			}
@@ -588,13 +779,17 @@ func (n *Node) ProcessIncomingWorkRequests(ctx context.Context, connection *Conn
 					select {
 					case w := <-manager.nextAvailableWorker:
 						finishedWork := w.Work(ctx, work)
-						SendWork(connection, finishedWork, nil)
 						manager.Lock()
 						manager.completedCount++
 						if finishedWork.status != 200 {
 							manager.completedFailureCount++
 						}
 						manager.Unlock()
+						if err := connection.SendWork(finishedWork, nil); err != nil {
+							cancel()
+							fmt.Println(n, "unable to return work:", err)
+							return
+						}
 					case <-ctx.Done():
 						// Didn't have enough workers to process, we need more cells.
 						// Signal growth ligand.
@@ -605,83 +800,112 @@ func (n *Node) ProcessIncomingWorkRequests(ctx context.Context, connection *Conn
 					cancel()
 				} else {
 					// Received completed work, which is not expected.
-					log.Fatalf("Should not receive completed work, got %v", work)
+					fmt.Println(n, &ProtocolError{
+						Address: connection.Address(),
+						Reason:  fmt.Sprintf("completed work sent as a request: %v", work),
+					})
 				}
 			}
 		}
 	}
 }
 
+// ReceiveDiffusion pools the materials a neighbor sent. Any part of the
+// payload left out counts as none of those materials.
 func (n *Node) ReceiveDiffusion(data *DiffusionSocketData) {
+	resources, waste, hormone := data.GetResources(), data.GetWaste(), data.GetHormone()
 	n.materialPool.PutResource(&ResourceBlob{
-		o2:       int(data.Resources.O2),
-		glucose:  int(data.Resources.Glucose),
-		vitamins: int(data.Resources.Vitamins),
+		o2:       int(resources.GetO2()),
+		glucose:  int(resources.GetGlucose()),
+		vitamins: int(resources.GetVitamins()),
 	})
 	n.materialPool.PutWaste(&WasteBlob{
-		co2:        int(data.Waste.CO2),
-		creatinine: int(data.Waste.Creatinine),
+		co2:        int(waste.GetCO2()),
+		creatinine: int(waste.GetCreatinine()),
+		ammonia:    int(waste.GetAmmonia()),
 	})
 	n.materialPool.PutHormone(&HormoneBlob{
-		granulocyte_csf: int(data.Hormone.GranulocyteColonyStimulatingFactor),
-		macrophage_csf:  int(data.Hormone.MacrophageColonyStimulatingFactor),
-		interleukin_3:   int(data.Hormone.Interleukin3),
-		interleukin_2:   int(data.Hormone.Interleukin2),
+		granulocyte_csf: int(hormone.GetGranulocyteColonyStimulatingFactor()),
+		macrophage_csf:  int(hormone.GetMacrophageColonyStimulatingFactor()),
+		interleukin_3:   int(hormone.GetInterleukin3()),
+		interleukin_2:   int(hormone.GetInterleukin2()),
+		complement:      int(hormone.GetComplement()),
+		insulin:         int(hormone.GetInsulin()),
+		glucagon:        int(hormone.GetGlucagon()),
+		interleukin_10:  int(hormone.GetInterleukin10()),
+	})
+	n.antigenPool.PutDiffusionLoad(data.GetAntigen())
+}
+
+func (n *Node) GetMaterialStatus() *MaterialStatusSocketData {
+	return &MaterialStatusSocketData{
+		O2:           int32(n.materialPool.resourcePool.resources.o2),
+		Glucose:      int32(n.materialPool.resourcePool.resources.glucose),
+		Vitamin:      int32(n.materialPool.resourcePool.resources.vitamins),
+		Co2:          int32(n.materialPool.wastePool.wastes.co2),
+		Creatinine:   int32(n.materialPool.wastePool.wastes.creatinine),
+		Growth:       int32(n.materialPool.ligandPool.ligands.growth),
+		Hunger:       int32(n.materialPool.ligandPool.ligands.hunger),
+		Asphyxia:     int32(n.materialPool.ligandPool.ligands.asphyxia),
+		Inflammation: int32(n.materialPool.ligandPool.ligands.inflammation),
+		GCsf:         int32(n.materialPool.hormonePool.hormones.granulocyte_csf),
+		MCsf:         int32(n.materialPool.hormonePool.hormones.macrophage_csf),
+		Il_3:         int32(n.materialPool.hormonePool.hormones.interleukin_3),
+		Il_2:         int32(n.materialPool.hormonePool.hormones.interleukin_2),
+		ViralLoad:    int32(n.antigenPool.GetViralLoad()),
+		AntibodyLoad: int32(n.antigenPool.GetAntibodyLoad()),
+		Ammonia:      int32(n.materialPool.wastePool.wastes.ammonia),
+		Glycogen:     int32(n.materialPool.resourcePool.resources.glycogen),
+		Complement:   int32(n.materialPool.hormonePool.hormones.complement),
+		Insulin:      int32(n.materialPool.hormonePool.hormones.insulin),
+		Glucagon:     int32(n.materialPool.hormonePool.hormones.glucagon),
+		Il_10:        int32(n.materialPool.hormonePool.hormones.interleukin_10),
+	}
+}
+
+func (n *Node) GetWorkCounts() map[WorkType]WorkCounts {
+	counts := map[WorkType]WorkCounts{}
+	n.managers.Range(func(w, m any) bool {
+		manager := m.(*WorkManager)
+		manager.Lock()
+		counts[w.(WorkType)] = manager.WorkCounts
+		manager.Unlock()
+		return true
 	})
-	n.antigenPool.PutDiffusionLoad(data.Antigen)
+	return counts
 }
 
 func (n *Node) GetNodeStatus(ctx context.Context, connection *Connection) {
 	defer connection.Close()
 	ticker := time.NewTicker(STATUS_SOCKET_CLOCK_RATE)
+	last := map[WorkType]WorkCounts{}
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
 			var connections []string
-			for _, edge := range n.edges {
-				address := strings.Replace(edge.workConnection.RemoteAddr().String(), "/work", "", 1)
-				connections = append(connections, address)
+			for _, edge := range n.Edges() {
+				edge.RLock()
+				connections = append(connections, edge.workConnection.Address())
+				edge.RUnlock()
 			}
+			// The counters are never reset, so each connection reports what
+			// changed since its own last update.
 			var workStatus []*WorkStatusSocketData
-			n.managers.Range(func(w, m any) bool {
-				workType := w.(WorkType)
-				manager := m.(*WorkManager)
-				manager.Lock()
+			for workType, counts := range n.GetWorkCounts() {
+				previous := last[workType]
 				workStatus = append(workStatus, &WorkStatusSocketData{
 					WorkType:              workType.String(),
-					RequestCount:          int32(manager.requestCount),
-					SuccessCount:          int32(manager.successCount),
-					FailureCount:          int32(manager.failureCount),
-					CompletedCount:        int32(manager.completedCount),
-					CompletedFailureCount: int32(manager.completedFailureCount),
+					RequestCount:          int32(counts.requestCount - previous.requestCount),
+					SuccessCount:          int32(counts.successCount - previous.successCount),
+					FailureCount:          int32(counts.failureCount - previous.failureCount),
+					CompletedCount:        int32(counts.completedCount - previous.completedCount),
+					CompletedFailureCount: int32(counts.completedFailureCount - previous.completedFailureCount),
 				})
-				manager.requestCount = 0
-				manager.successCount = 0
-				manager.failureCount = 0
-				manager.completedCount = 0
-				manager.completedFailureCount = 0
-				manager.Unlock()
-				return true
-			})
-			materialStatus := &MaterialStatusSocketData{
-				O2:           int32(n.materialPool.resourcePool.resources.o2),
-				Glucose:      int32(n.materialPool.resourcePool.resources.glucose),
-				Vitamin:      int32(n.materialPool.resourcePool.resources.vitamins),
-				Co2:          int32(n.materialPool.wastePool.wastes.co2),
-				Creatinine:   int32(n.materialPool.wastePool.wastes.creatinine),
-				Growth:       int32(n.materialPool.ligandPool.ligands.growth),
-				Hunger:       int32(n.materialPool.ligandPool.ligands.hunger),
-				Asphyxia:     int32(n.materialPool.ligandPool.ligands.asphyxia),
-				Inflammation: int32(n.materialPool.ligandPool.ligands.inflammation),
-				GCsf:         int32(n.materialPool.hormonePool.hormones.granulocyte_csf),
-				MCsf:         int32(n.materialPool.hormonePool.hormones.macrophage_csf),
-				Il_3:         int32(n.materialPool.hormonePool.hormones.interleukin_3),
-				Il_2:         int32(n.materialPool.hormonePool.hormones.interleukin_2),
-				ViralLoad:    int32(n.antigenPool.GetViralLoad()),
-				AntibodyLoad: int32(n.antigenPool.GetAntibodyLoad()),
+				last[workType] = counts
 			}
+			materialStatus := n.GetMaterialStatus()
 			err := SendStatus(connection, &StatusSocketData{
 				Status:         200,
 				Name:           n.name,
@@ -721,8 +945,14 @@ func (n *Node) RequestWork(ctx context.Context, request Work) (result Work) {
 			fmt.Printf("%v Received Result: %v\n", n, result)
 		}
 	default:
-		for _, edge := range n.edges {
-			SendWork(edge.workConnection, request, nil)
+		for _, edge := range n.Edges() {
+			if !edge.Healthy() {
+				continue
+			}
+			err := edge.SendWork(request, nil)
+			if err != nil && n.verbose {
+				fmt.Println(n, "unable to request work:", err)
+			}
 		}
 	}
 	if result.status == 0 {
@@ -752,12 +982,12 @@ func (n *Node) RequestWork(ctx context.Context, request Work) (result Work) {
 
 func (n *Node) SendDiffusion(ctx context.Context) {
 	if n.materialPool != nil {
-		if len(n.edges) == 0 {
-			return
-		}
 		// Pick a random, valid edge to diffuse to.
 		var diffusionEdges []*Edge
-		for _, e := range n.edges {
+		for _, e := range n.Edges() {
+			if !e.Healthy() {
+				continue
+			}
 			switch e.edgeType {
 			case neuronal:
 				// Pass
@@ -768,7 +998,7 @@ func (n *Node) SendDiffusion(ctx context.Context) {
 		if len(diffusionEdges) == 0 {
 			return
 		}
-		edge := diffusionEdges[rand.Intn(len(diffusionEdges))]
+		edge := diffusionEdges[n.diffusionRand.Intn(len(diffusionEdges))]
 
 		// Grab a resource, waste, and hormone blob to diffuse. Can be empty.
 		resource := n.materialPool.SplitResource(ctx)
@@ -783,37 +1013,61 @@ func (n *Node) SendDiffusion(ctx context.Context) {
 			Waste: &WasteBlobSocketData{
 				CO2:        int32(waste.co2),
 				Creatinine: int32(waste.creatinine),
+				Ammonia:    int32(waste.ammonia),
 			},
 			Hormone: &HormoneBlobSocketData{
 				GranulocyteColonyStimulatingFactor: int32(hormone.granulocyte_csf),
 				MacrophageColonyStimulatingFactor:  int32(hormone.macrophage_csf),
 				Interleukin3:                       int32(hormone.interleukin_3),
 				Interleukin2:                       int32(hormone.interleukin_2),
+				Complement:                         int32(hormone.complement),
+				Insulin:                            int32(hormone.insulin),
+				Glucagon:                           int32(hormone.glucagon),
+				Interleukin10:                      int32(hormone.interleukin_10),
 			},
 			Antigen: n.antigenPool.GetDiffusionLoad(),
 		}
-		SendWork(edge.workConnection, Work{
+		err := edge.SendWork(Work{
 			workType: WorkType_diffusion,
 			status:   0,
 		}, diffusionData)
+		if err != nil {
+			fmt.Println(n, "unable to diffuse:", err)
+		}
 
 		// If there are viral loads that are greater than max, deposit it.
 		for _, viralLoad := range n.antigenPool.GetExcessViralLoad() {
 			virusDNA := viralLoad.virus.dna
 			fmt.Println("Viral load diffused to", edge.transportUrl)
-			MakeTransportRequest(edge.transportUrl, virusDNA.name, virusDNA, CellType_ViralLoadCarrier, WorkType_nothing, "", time.Now(), [10]string{}, [10]string{}, nil)
+			err := n.MakeTransportRequest(edge.transportUrl, virusDNA.name, virusDNA, CellType_ViralLoadCarrier, WorkType_nothing, "", n.clock.Now(), [10]string{}, [10]string{}, nil, Lineage{}, n.diffusionRand.Int63())
+			if err == nil {
+				atomic.AddInt64(&edge.transportCount, 1)
+			}
 		}
 	}
 }
 
-func (n *Node) ProcessIncomingWorkResponses(ctx context.Context, connection *Connection) {
+// ProcessIncomingWorkResponses returns once the connection can no longer be
+// used.
+func (n *Node) ProcessIncomingWorkResponses(ctx context.Context, edge *Edge, connection WorkConnection) error {
 	for {
 		select {
 		case <-ctx.Done():
-			log.Fatalln("Node cannot process work responses")
-			return
+			return ctx.Err()
 		default:
-			work, _ := ReceiveWork(connection)
+			work, _, err := connection.ReceiveWork()
+			var protocolError *ProtocolError
+			if errors.As(err, &protocolError) {
+				fmt.Println(n, err)
+				continue
+			} else if err != nil {
+				return err
+			}
+			edge.Seen(work)
+			if work.workType == WorkType_nothing {
+				// A heartbeat.
+				continue
+			}
 			if n.verbose {
 				fmt.Printf("%v Received Response: %v\n", n, work)
 			}
@@ -822,14 +1076,20 @@ func (n *Node) ProcessIncomingWorkResponses(ctx context.Context, connection *Con
 				manager := m.(*WorkManager)
 				if work.status == 0 {
 					// Received incompleted work, which is not expected.
-					log.Fatalf("Should not receive incompleted work, got %v", work)
+					fmt.Println(n, &ProtocolError{
+						Address: connection.Address(),
+						Reason:  fmt.Sprintf("incomplete work sent as a response: %v", work),
+					})
 				} else {
 					// Received completed work.
 					manager.resultChan <- work
 				}
 			} else {
 				// Received completed work, but not expected.
-				log.Fatalf("Received completed work, but unable to process it. Got %v", work)
+				fmt.Println(n, &ProtocolError{
+					Address: connection.Address(),
+					Reason:  fmt.Sprintf("unable to process completed work: %v", work),
+				})
 			}
 		}
 	}
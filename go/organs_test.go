@@ -14,6 +14,7 @@ type TestWorker struct {
 func TestSelfNodeInteraction(t *testing.T) {
 	testGraph := &Graph{
 		allNodes: make(map[string]*Node),
+		clock:    InitializeClock(),
 	}
 	ctx := context.Background()
 	node1 := InitializeNewNode(ctx, testGraph, "node1", false)
@@ -48,6 +49,7 @@ func TestSelfNodeInteraction(t *testing.T) {
 func TestTwoNodeInteraction(t *testing.T) {
 	testGraph := &Graph{
 		allNodes: make(map[string]*Node),
+		clock:    InitializeClock(),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -111,6 +113,7 @@ func TestTwoNodeInteraction(t *testing.T) {
 func TestThreeNodeInteraction(t *testing.T) {
 	testGraph := &Graph{
 		allNodes: make(map[string]*Node),
+		clock:    InitializeClock(),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -181,3 +184,129 @@ func TestThreeNodeInteraction(t *testing.T) {
 		t.Errorf("Expected status to be 200, got: %v", result.status)
 	}
 }
+
+func TestThymicSelection(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(1000, 0), []*NodeSnapshot{{Name: "Selection Thymus", Organ: "thymus"}})
+	node := body.FindNode("Selection Thymus")
+	if !InThymus(node) {
+		t.Fatal("Expected the thymus to select T cells")
+	}
+	epithelial := body.restore(node, body.human, &CellSnapshot{CellType: CellType_ThymicEpithelial})
+	presented := map[Protein]bool{}
+	for _, protein := range epithelial.PresentAntigen().proteins {
+		presented[protein] = true
+	}
+	self := epithelial.PresentAntigen().proteins[0]
+	other := self + 1
+	for presented[other] {
+		other++
+	}
+
+	autoreactive := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Thymocyte}, self).(*Thymocyte)
+	autoreactive.Interact(context.Background(), epithelial)
+	if !autoreactive.IsApoptosis() {
+		t.Error("Expected a thymocyte that binds self to die")
+	}
+
+	tolerant := body.restore(node, body.human, &CellSnapshot{CellType: CellType_Thymocyte}, other).(*Thymocyte)
+	for i := 0; i < THYMOCYTE_SELECTIONS; i++ {
+		if tolerant.WillMitosis(context.Background()) {
+			t.Fatal("Expected a thymocyte to mature only once selected enough")
+		}
+		tolerant.Interact(context.Background(), epithelial)
+	}
+	if tolerant.IsApoptosis() || !tolerant.WillMitosis(context.Background()) {
+		t.Error("Expected a thymocyte that tolerates self to mature")
+	}
+}
+
+func TestSpleen(t *testing.T) {
+	now := time.Unix(100000, 0)
+	body := restoreTestBody(t, now, []*NodeSnapshot{
+		{Name: "Filter Spleen", Organ: "spleen"},
+		{Name: "Filter Blood", Organ: "blood"},
+	})
+	spleen := body.FindNode("Filter Spleen")
+	if !InSpleen(spleen) || InSpleen(body.FindNode("Filter Blood")) {
+		t.Fatal("Expected only the spleen to filter the blood")
+	}
+	macrophage := body.restore(spleen, body.human, &CellSnapshot{CellType: CellType_Macrophagocyte, WorkType: WorkType_exchange}).(*Macrophage)
+	young := body.restore(spleen, body.human, &CellSnapshot{CellType: CellType_RedBlood, WorkType: WorkType_exchange, SpawnTime: now.Add(-RED_BLOOD_LIFE_SPAN / 2).UnixNano()})
+	aged := body.restore(spleen, body.human, &CellSnapshot{CellType: CellType_RedBlood, WorkType: WorkType_exchange, SpawnTime: now.Add(-2 * RED_BLOOD_LIFE_SPAN).UnixNano()})
+	macrophage.DoWork(context.Background())
+	if young.IsApoptosis() {
+		t.Error("Expected a young red blood cell to be left alone")
+	}
+	if !aged.IsApoptosis() {
+		t.Error("Expected an aged red blood cell to be retired")
+	}
+
+	virus := MakeDNA(VIRUS_RNA, "Filtered Virus")
+	spleen.antigenPool.DepositViralLoad(&ViralLoad{
+		virus:         &Virus{dna: virus, infectivity: 1},
+		concentration: 3 * MACROPHAGE_VIRAL_FILTER_RATE,
+	})
+	if filtered := spleen.antigenPool.FilterOpsonizedViralLoad(MACROPHAGE_VIRAL_FILTER_RATE); filtered != 0 {
+		t.Errorf("Expected unmarked virus to pass through, filtered: %v", filtered)
+	}
+	spleen.antigenPool.DepositAntibodyLoad(&AntibodyLoad{
+		targetProtein: virus.selfProteins[0],
+		concentration: 1,
+	})
+	if filtered := spleen.antigenPool.FilterOpsonizedViralLoad(MACROPHAGE_VIRAL_FILTER_RATE); filtered != MACROPHAGE_VIRAL_FILTER_RATE {
+		t.Errorf("Expected marked virus to be filtered, filtered: %v", filtered)
+	}
+	if spleen.antigenPool.GetViralLoad() != 2*MACROPHAGE_VIRAL_FILTER_RATE || spleen.antigenPool.GetAntibodyLoad() != 1 {
+		t.Errorf("Expected the antibodies to outlast the virus they marked, got: %v virus, %v antibodies", spleen.antigenPool.GetViralLoad(), spleen.antigenPool.GetAntibodyLoad())
+	}
+}
+
+func TestSenescence(t *testing.T) {
+	now := time.Unix(100000, 0)
+	body := restoreTestBody(t, now, []*NodeSnapshot{
+		{Name: "Senescent Blood", Organ: "blood"},
+		{Name: "Senescent Spleen", Organ: "spleen"},
+		{Name: "Senescent Bone", Organ: "bone"},
+	},
+		&EdgeSnapshot{From: "Senescent Blood", To: "Senescent Spleen", EdgeType: int32(splenic)},
+		&EdgeSnapshot{From: "Senescent Blood", To: "Senescent Bone", EdgeType: int32(skeletal)},
+	)
+	blood, spleen, bone := body.FindNode("Senescent Blood"), body.FindNode("Senescent Spleen"), body.FindNode("Senescent Bone")
+	aged := body.restore(blood, body.human, &CellSnapshot{
+		CellType:        CellType_RedBlood,
+		WorkType:        WorkType_exchange,
+		SpawnTime:       now.Add(-2 * RED_BLOOD_LIFE_SPAN).UnixNano(),
+		LineageId:       "aged",
+		ParentLineageId: "hemocytoblast",
+	})
+	if Senesce(context.Background(), aged) {
+		t.Fatal("Expected the aged red blood cell to leave")
+	}
+	if len(spleen.Cells()) != 1 || spleen.Cells()[0].Lineage().ID != "aged" {
+		t.Errorf("Expected the aged cell to reach the spleen, got: %v", spleen.Cells())
+	}
+	// The aged cell is only cleaned up from the blood once it stops running.
+	if len(blood.Cells()) != 1 || len(bone.Cells()) != 1 {
+		t.Fatalf("Expected the marrow to make the new cell, got %v in the blood, %v in the bone", len(blood.Cells()), len(bone.Cells()))
+	}
+	replacement := bone.Cells()[0]
+	if replacement.CellType() != CellType_RedBlood || replacement.Lineage().Parent != "hemocytoblast" || replacement.Lineage().ID == "aged" {
+		t.Errorf("Expected a new red blood cell from the same stem cell, got: %v %+v", replacement, replacement.Lineage())
+	}
+}
+
+func TestMalformedDiffusion(t *testing.T) {
+	body := restoreTestBody(t, time.Unix(1000, 0), []*NodeSnapshot{{Name: "Malformed Blood", Organ: "blood"}})
+	node := body.FindNode("Malformed Blood")
+	connection, peer := MakeMemoryConnections("Malformed Muscle", "Malformed Blood")
+	// A payload with parts left out only carries the parts it has.
+	peer.SendWork(Work{workType: WorkType_diffusion}, &DiffusionSocketData{Hormone: &HormoneBlobSocketData{Insulin: 5}})
+	peer.SendWork(Work{workType: WorkType_diffusion}, nil)
+	node.ProcessIncomingWorkRequests(context.Background(), connection)
+	if status := node.GetMaterialStatus(); status.Insulin != 5 {
+		t.Errorf("Expected the partial diffusion to arrive, got: %v", status.Insulin)
+	}
+	if _, _, err := peer.ReceiveWork(); err == nil {
+		t.Error("Expected a diffusion without a payload to close the connection")
+	}
+}
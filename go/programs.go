@@ -0,0 +1,521 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed programs/cells.json
+var defaultPrograms []byte
+
+// Programs describe what cells do, one step per tick of their clock. Each cell
+// type runs the program named after it and a virus runs "Virus/" followed by
+// its pathogen name, or "Virus" if there's none, grafted onto the cell it
+// infects. When a program runs out of steps, it starts over.
+type Programs struct {
+	Programs map[string][]Step `json:"programs"`
+}
+
+// A Step runs a named CellAction, or calls another program in its place. After
+// the step, the cell goes to the step labeled by Goto, if its condition holds
+// or it has none. A step with only a Goto just senses, and so does a call with
+// a Goto, for a tick after the called program, so that the called program's
+// own branches are kept.
+type Step struct {
+	Label    string     `json:"label"`
+	Action   string     `json:"action"`
+	Call     string     `json:"call"`
+	Repeat   int        `json:"repeat"` // Run the step this many times in a row.
+	If       *Condition `json:"if"`
+	Goto     string     `json:"goto"`
+	Proteins []Protein  `json:"proteins"` // Defaults to a random sample of the DNA's.
+}
+
+var cellActions = map[string]CellAction{}
+
+// Some actions only make sense for cell types that can do them.
+var cellActionCapabilities = map[string]func(spec *CellTypeSpec) bool{
+	"DoWork":   func(spec *CellTypeSpec) bool { return spec.DoesWork },
+	"Interact": func(spec *CellTypeSpec) bool { return spec.CanInteract },
+}
+
+func RegisterCellAction(name string, action CellAction) {
+	if _, ok := cellActions[name]; ok {
+		panic(fmt.Sprintf("Cell action registered twice: %v", name))
+	}
+	cellActions[name] = action
+}
+
+// Sense takes a tick without doing anything, for steps that only branch.
+func Sense(ctx context.Context, cell CellActor) bool {
+	return true
+}
+
+func init() {
+	for name, action := range map[string]CellAction{
+		"Sense":                                      Sense,
+		"DoWork":                                     DoWork,
+		"Explore":                                    Explore,
+		"MoveTowardsChemotaxisCytokineOrExplore":     MoveTowardsChemotaxisCytokineOrExplore,
+		"MoveTowardsCellDamageCytokineOrExplore":     MoveTowardsCellDamageCytokineOrExplore,
+		"MoveTowardsCellStressCytokineOrExplore":     MoveTowardsCellStressCytokineOrExplore,
+		"MoveTowardsAntigenPresentCytokineOrExplore": MoveTowardsAntigenPresentCytokineOrExplore,
+		"MoveTowardsPhagocyteCytokinesOrExplore":     MoveTowardsPhagocyteCytokinesOrExplore,
+		"WillMitosisAndRepair":                       WillMitosisAndRepair,
+		"ShouldApoptosis":                            ShouldApoptosis,
+		"Apoptosis":                                  Apoptosis,
+		"Respirate":                                  Respirate,
+		"Expirate":                                   Expirate,
+		"Filtrate":                                   Filtrate,
+		"Detoxify":                                   Detoxify,
+		"RegulateGlucose":                            RegulateGlucose,
+		"ReplenishComplement":                        ReplenishComplement,
+		"Senesce":                                    Senesce,
+		"SecreteInsulin":                             SecreteInsulin,
+		"SecreteGlucagon":                            SecreteGlucagon,
+		"MuscleFindFood":                             MuscleFindFood,
+		"MuscleSeekSkinProtection":                   MuscleSeekSkinProtection,
+		"BrainStimulateMuscles":                      BrainStimulateMuscles,
+		"BrainRequestPump":                           BrainRequestPump,
+		"CheckVitaminLevels":                         CheckVitaminLevels,
+		"Flatulate":                                  Flatulate,
+		"Interact":                                   Interact,
+		"ShouldTransport":                            ShouldTransport,
+		"BacteriaMoveAwayFromCytokinesOrExplore":     BacteriaMoveAwayFromCytokinesOrExplore,
+		"BacteriaWillMitosis":                        BacteriaWillMitosis,
+		"BacteriaConsume":                            BacteriaConsume,
+		"FixComplement":                              FixComplement,
+		"MakeVirusProtein":                           MakeVirusProtein,
+		"ProduceInterferon":                          ProduceInterferon,
+	} {
+		RegisterCellAction(name, action)
+	}
+}
+
+// Measure is something a cell can sense, to branch on.
+type Measure func(ctx context.Context, cell CellActor) int
+
+var measures = map[string]Measure{
+	"o2": func(ctx context.Context, cell CellActor) int {
+		return senseResource(ctx, cell).o2
+	},
+	"glucose": func(ctx context.Context, cell CellActor) int {
+		return senseResource(ctx, cell).glucose
+	},
+	"vitamins": func(ctx context.Context, cell CellActor) int {
+		return senseResource(ctx, cell).vitamins
+	},
+	"glycogen": func(ctx context.Context, cell CellActor) int {
+		return senseResource(ctx, cell).glycogen
+	},
+	"co2": func(ctx context.Context, cell CellActor) int {
+		return senseWaste(ctx, cell).co2
+	},
+	"creatinine": func(ctx context.Context, cell CellActor) int {
+		return senseWaste(ctx, cell).creatinine
+	},
+	"ammonia": func(ctx context.Context, cell CellActor) int {
+		return senseWaste(ctx, cell).ammonia
+	},
+	"growth": func(ctx context.Context, cell CellActor) int {
+		return senseLigand(ctx, cell).growth
+	},
+	"hunger": func(ctx context.Context, cell CellActor) int {
+		return senseLigand(ctx, cell).hunger
+	},
+	"asphyxia": func(ctx context.Context, cell CellActor) int {
+		return senseLigand(ctx, cell).asphyxia
+	},
+	"inflammation": func(ctx context.Context, cell CellActor) int {
+		return senseLigand(ctx, cell).inflammation
+	},
+	"granulocyteCsf": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).granulocyte_csf
+	},
+	"macrophageCsf": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).macrophage_csf
+	},
+	"interleukin3": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).interleukin_3
+	},
+	"interleukin2": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).interleukin_2
+	},
+	"complement": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).complement
+	},
+	"insulin": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).insulin
+	},
+	"glucagon": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).glucagon
+	},
+	"interleukin10": func(ctx context.Context, cell CellActor) int {
+		return senseHormone(ctx, cell).interleukin_10
+	},
+	"damage": func(ctx context.Context, cell CellActor) int {
+		return cell.Damage()
+	},
+	"viralLoad": func(ctx context.Context, cell CellActor) int {
+		viralLoad := cell.ViralLoad()
+		if viralLoad == nil {
+			return 0
+		}
+		viralLoad.RLock()
+		defer viralLoad.RUnlock()
+		return int(viralLoad.concentration)
+	},
+	"antibodyLoad": func(ctx context.Context, cell CellActor) int {
+		antibodyLoad := cell.AntibodyLoad()
+		if antibodyLoad == nil {
+			return 0
+		}
+		antibodyLoad.RLock()
+		defer antibodyLoad.RUnlock()
+		return int(antibodyLoad.concentration)
+	},
+	"presented": func(ctx context.Context, cell CellActor) int {
+		return len(cell.MHC_II().GetPresented())
+	},
+}
+
+func senseResource(ctx context.Context, cell CellActor) ResourceBlob {
+	resource := cell.Organ().materialPool.GetResource(ctx)
+	defer cell.Organ().materialPool.PutResource(resource)
+	return *resource
+}
+
+func senseWaste(ctx context.Context, cell CellActor) WasteBlob {
+	waste := cell.Organ().materialPool.GetWaste(ctx)
+	defer cell.Organ().materialPool.PutWaste(waste)
+	return *waste
+}
+
+func senseLigand(ctx context.Context, cell CellActor) LigandBlob {
+	ligand := cell.Organ().materialPool.GetLigand(ctx)
+	defer cell.Organ().materialPool.PutLigand(ligand)
+	return *ligand
+}
+
+func senseHormone(ctx context.Context, cell CellActor) HormoneBlob {
+	hormone := cell.Organ().materialPool.GetHormone(ctx)
+	defer cell.Organ().materialPool.PutHormone(hormone)
+	return *hormone
+}
+
+// Condition compares a measure to a value, and reads as one, like
+// "inflammation > 10".
+type Condition struct {
+	Measure string
+	Op      string
+	Value   int
+}
+
+func ParseCondition(s string) (*Condition, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("condition %q: expected a measure, a comparison and a value", s)
+	}
+	if _, ok := measures[fields[0]]; !ok {
+		return nil, fmt.Errorf("condition %q: unknown measure: %q", s, fields[0])
+	}
+	switch fields[1] {
+	case "<", "<=", ">", ">=", "==", "!=":
+	default:
+		return nil, fmt.Errorf("condition %q: unknown comparison: %q", s, fields[1])
+	}
+	value, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("condition %q: %w", s, err)
+	}
+	return &Condition{
+		Measure: fields[0],
+		Op:      fields[1],
+		Value:   value,
+	}, nil
+}
+
+func (c *Condition) String() string {
+	return fmt.Sprintf("%v %v %v", c.Measure, c.Op, c.Value)
+}
+
+func (c *Condition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *Condition) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	condition, err := ParseCondition(s)
+	if err != nil {
+		return err
+	}
+	*c = *condition
+	return nil
+}
+
+func (c *Condition) Holds(ctx context.Context, cell CellActor) bool {
+	measured := measures[c.Measure](ctx, cell)
+	switch c.Op {
+	case "<":
+		return measured < c.Value
+	case "<=":
+		return measured <= c.Value
+	case ">":
+		return measured > c.Value
+	case ">=":
+		return measured >= c.Value
+	case "==":
+		return measured == c.Value
+	case "!=":
+		return measured != c.Value
+	}
+	return false
+}
+
+// ParsePrograms reads programs over the default ones, so a file only needs the
+// programs it changes.
+func ParsePrograms(data []byte) (*Programs, error) {
+	programs := &Programs{}
+	err := json.Unmarshal(defaultPrograms, programs)
+	if err == nil {
+		// Unmarshaling into the same map keeps the programs that aren't replaced.
+		err = json.Unmarshal(data, programs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("programs: %w", err)
+	}
+	err = programs.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("programs: %w", err)
+	}
+	return programs, nil
+}
+
+func LoadPrograms(path string) (*Programs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("programs: %w", err)
+	}
+	return ParsePrograms(data)
+}
+
+func DefaultPrograms() *Programs {
+	programs, err := ParsePrograms(defaultPrograms)
+	if err != nil {
+		panic(err)
+	}
+	return programs
+}
+
+var (
+	cellProgramsMu sync.Mutex
+	cellPrograms   *Programs
+)
+
+// UsePrograms sets the programs cells are started with from now on, or the
+// default ones if nil. Cells already running keep theirs.
+func UsePrograms(p *Programs) {
+	cellProgramsMu.Lock()
+	defer cellProgramsMu.Unlock()
+	cellPrograms = p
+}
+
+func CellPrograms() *Programs {
+	cellProgramsMu.Lock()
+	defer cellProgramsMu.Unlock()
+	if cellPrograms == nil {
+		cellPrograms = DefaultPrograms()
+	}
+	return cellPrograms
+}
+
+func (p *Programs) Validate() error {
+	for name, steps := range p.Programs {
+		if len(steps) == 0 {
+			return fmt.Errorf("program %q has no steps", name)
+		}
+		labels := map[string]bool{}
+		for _, step := range steps {
+			if step.Label == "" {
+				continue
+			}
+			if labels[step.Label] {
+				return fmt.Errorf("program %q: duplicate label: %q", name, step.Label)
+			}
+			labels[step.Label] = true
+		}
+		for i, step := range steps {
+			switch {
+			case step.Action != "" && step.Call != "":
+				return fmt.Errorf("program %q: step %v both acts and calls", name, i)
+			case step.Call != "":
+				if _, ok := p.Programs[step.Call]; !ok {
+					return fmt.Errorf("program %q: step %v calls unknown program: %q", name, i, step.Call)
+				}
+			case step.Action != "":
+				if _, ok := cellActions[step.Action]; !ok {
+					return fmt.Errorf("program %q: step %v has unknown action: %q", name, i, step.Action)
+				}
+			case step.Goto == "":
+				return fmt.Errorf("program %q: step %v does nothing", name, i)
+			}
+			if step.Goto != "" && !labels[step.Goto] {
+				return fmt.Errorf("program %q: step %v goes to unknown label: %q", name, i, step.Goto)
+			}
+			if step.If != nil && step.Goto == "" {
+				return fmt.Errorf("program %q: step %v has a condition but nowhere to go", name, i)
+			}
+			if step.Repeat < 0 {
+				return fmt.Errorf("program %q: step %v repeats %v times", name, i, step.Repeat)
+			}
+		}
+	}
+	for name := range p.Programs {
+		if _, err := p.Actions(name); err != nil {
+			return err
+		}
+	}
+	for cellType, spec := range cellTypes {
+		name := cellType.String()
+		if spec.DNAType == VIRUS_RNA {
+			// Virus carriers never start, the virus runs in the cells it infects.
+			name = "Virus"
+		}
+		actions, err := p.Actions(name)
+		if err != nil {
+			return fmt.Errorf("%v: %w", cellType, err)
+		}
+		if spec.DNAType == VIRUS_RNA {
+			continue
+		}
+		for action := range actions {
+			if capable, ok := cellActionCapabilities[action]; ok && !capable(spec) {
+				return fmt.Errorf("program %q: %v can't %v", name, cellType, action)
+			}
+		}
+	}
+	return nil
+}
+
+// Actions lists every action a program runs, including those of the programs
+// it calls.
+func (p *Programs) Actions(name string) (map[string]bool, error) {
+	actions := map[string]bool{}
+	return actions, p.collectActions(name, actions, map[string]bool{})
+}
+
+func (p *Programs) collectActions(name string, actions map[string]bool, calling map[string]bool) error {
+	steps, ok := p.Programs[name]
+	if !ok {
+		return fmt.Errorf("no program named %q", name)
+	}
+	if calling[name] {
+		return fmt.Errorf("program %q calls itself", name)
+	}
+	calling[name] = true
+	defer delete(calling, name)
+	for _, step := range steps {
+		if step.Call != "" {
+			if err := p.collectActions(step.Call, actions, calling); err != nil {
+				return err
+			}
+		} else if step.Action != "" {
+			actions[step.Action] = true
+		}
+	}
+	return nil
+}
+
+// Compile builds a state diagram from a program for a cell, with calls
+// inlined and repeats unrolled, so that every step lies on the way from the
+// root back to it.
+func (p *Programs) Compile(name string, c CellActor, dna *DNA) *StateDiagram {
+	if _, ok := p.Programs[name]; !ok {
+		panic(fmt.Sprintf("No program named %q", name))
+	}
+	nodes := p.compile(name, c, dna)
+	for i, node := range nodes {
+		node.next = nodes[(i+1)%len(nodes)]
+	}
+	return &StateDiagram{
+		root: nodes[0],
+	}
+}
+
+func (p *Programs) compile(name string, c CellActor, dna *DNA) (nodes []*StateNode) {
+	labels := map[string]*StateNode{}
+	jumps := map[*StateNode]string{}
+	for _, step := range p.Programs[name] {
+		repeat := step.Repeat
+		if repeat == 0 {
+			repeat = 1
+		}
+		for i := 0; i < repeat; i++ {
+			var stepNodes []*StateNode
+			if step.Call != "" {
+				stepNodes = p.compile(step.Call, c, dna)
+				if step.Goto != "" {
+					stepNodes = append(stepNodes, makeStepNode(Sense, step, c, dna))
+				}
+			} else {
+				action := cellActions[step.Action]
+				if step.Action == "" {
+					action = Sense
+				}
+				stepNodes = []*StateNode{makeStepNode(action, step, c, dna)}
+			}
+			if i == 0 && step.Label != "" {
+				labels[step.Label] = stepNodes[0]
+			}
+			if step.Goto != "" {
+				last := stepNodes[len(stepNodes)-1]
+				last.condition = step.If
+				jumps[last] = step.Goto
+			}
+			nodes = append(nodes, stepNodes...)
+		}
+	}
+	for node, label := range jumps {
+		node.jump = labels[label]
+	}
+	return
+}
+
+func makeStepNode(action CellAction, step Step, c CellActor, dna *DNA) *StateNode {
+	proteins := step.Proteins
+	if len(proteins) == 0 {
+		proteins = GenerateRandomProteinPermutation(c.Rand(), dna)
+	}
+	return &StateNode{
+		function: &ProteinFunction{
+			action:   action,
+			proteins: proteins,
+		},
+	}
+}
+
+// VirusProgram names the program a virus hijacks its host with.
+func (p *Programs) VirusProgram(pathogen string) string {
+	if _, ok := p.Programs["Virus/"+pathogen]; ok {
+		return "Virus/" + pathogen
+	}
+	return "Virus"
+}
+
+func MakeStateDiagramByCellType(c CellActor, dna *DNA) *StateDiagram {
+	return CellPrograms().Compile(c.CellType().String(), c, dna)
+}
+
+func MakeStateDiagramByVirus(c CellActor, dna *DNA) *StateDiagram {
+	programs := CellPrograms()
+	return programs.Compile(programs.VirusProgram(dna.name), c, dna)
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultPrograms(t *testing.T) {
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	cell := MakeCellFromType(CellType_Enterocyte, WorkType_digest, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	diagram := DefaultPrograms().Compile("Enterocyte", cell, human)
+	var actions []string
+	for node := diagram.root; ; node = node.next {
+		for name, action := range cellActions {
+			if reflect.ValueOf(action).Pointer() == reflect.ValueOf(node.function.action).Pointer() {
+				actions = append(actions, name)
+			}
+		}
+		if len(node.function.proteins) == 0 {
+			t.Errorf("Expected %v to carry proteins", actions[len(actions)-1])
+		}
+		if node.next == diagram.root {
+			break
+		}
+	}
+	want := []string{"WillMitosisAndRepair", "Flatulate", "Respirate", "Respirate", "CheckVitaminLevels", "DoWork", "ShouldApoptosis"}
+	if !reflect.DeepEqual(actions, want) {
+		t.Errorf("Expected the repeat and the call to be unrolled, got: %v", actions)
+	}
+}
+
+func TestProgramBranches(t *testing.T) {
+	programs, err := ParsePrograms([]byte(`{"programs": {"Keratinocyte": [
+		{"label": "top", "action": "WillMitosisAndRepair", "proteins": [1, 2, 3]},
+		{"if": "damage > 3", "goto": "hurt"},
+		{"action": "DoWork", "goto": "top"},
+		{"label": "hurt", "call": "work", "repeat": 2}
+	]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(programs.Programs["Neutrocyte"]) == 0 {
+		t.Error("Expected the programs that weren't replaced to be kept")
+	}
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	cell := MakeCellFromType(CellType_Keratinocyte, WorkType_cover, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand).(*EukaryoticCell)
+	diagram := programs.Compile("Keratinocyte", cell, human)
+	var nodes []*StateNode
+	for node := diagram.root; len(nodes) == 0 || node != diagram.root; node = node.next {
+		nodes = append(nodes, node)
+	}
+	if len(nodes) != 7 {
+		t.Fatalf("Expected 7 steps, got %v", len(nodes))
+	}
+	if !reflect.DeepEqual(nodes[0].function.proteins, []Protein{1, 2, 3}) {
+		t.Errorf("Expected the step's own proteins, got: %v", nodes[0].function.proteins)
+	}
+	ctx := context.Background()
+	if next := nodes[1].Next(ctx, cell); next != nodes[2] {
+		t.Error("Expected an undamaged cell to carry on")
+	}
+	cell.Cell.IncurDamage(5)
+	if next := nodes[1].Next(ctx, cell); next != nodes[3] {
+		t.Error("Expected a damaged cell to branch")
+	}
+	if next := nodes[2].Next(ctx, cell); next != nodes[0] {
+		t.Error("Expected to loop back to the top")
+	}
+	if next := nodes[6].Next(ctx, cell); next != nodes[0] {
+		t.Error("Expected the program to start over")
+	}
+	diagram.SetCursor(3)
+	if diagram.current != nodes[3] || diagram.Cursor() != 3 {
+		t.Errorf("Expected the cursor to reach every step, got %v", diagram.Cursor())
+	}
+}
+
+func TestProgramCallBranches(t *testing.T) {
+	programs, err := ParsePrograms([]byte(`{"programs": {
+		"check": [{"label": "again", "action": "Respirate"}, {"if": "damage > 3", "goto": "again"}],
+		"Keratinocyte": [{"label": "top", "action": "WillMitosisAndRepair"}, {"call": "check", "if": "damage > 10", "goto": "top"}, {"action": "DoWork"}]
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	cell := MakeCellFromType(CellType_Keratinocyte, WorkType_cover, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand).(*EukaryoticCell)
+	diagram := programs.Compile("Keratinocyte", cell, human)
+	var nodes []*StateNode
+	for node := diagram.root; len(nodes) == 0 || node != diagram.root; node = node.next {
+		nodes = append(nodes, node)
+	}
+	if len(nodes) != 5 {
+		t.Fatalf("Expected the call's branch to take a step of its own, got %v steps", len(nodes))
+	}
+	ctx := context.Background()
+	cell.Cell.IncurDamage(5)
+	if next := nodes[2].Next(ctx, cell); next != nodes[1] {
+		t.Error("Expected the called program to keep its own branch")
+	}
+	if next := nodes[3].Next(ctx, cell); next != nodes[4] {
+		t.Error("Expected the call's branch not to hold yet")
+	}
+	cell.Cell.IncurDamage(10)
+	if next := nodes[3].Next(ctx, cell); next != nodes[0] {
+		t.Error("Expected the call's branch to go to the top")
+	}
+}
+
+func TestVirusPrograms(t *testing.T) {
+	programs := DefaultPrograms()
+	if name := programs.VirusProgram("SARS-COV-2"); name != "Virus/SARS-COV-2" {
+		t.Errorf("Expected SARS-COV-2 to run its own program, got: %v", name)
+	}
+	if name := programs.VirusProgram("Unknown Virus"); name != "Virus" {
+		t.Errorf("Expected other viruses to run the generic program, got: %v", name)
+	}
+	programs, err := ParsePrograms([]byte(`{"programs": {"Virus/Program Virus": [{"action": "MakeVirusProtein"}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	UsePrograms(programs)
+	defer UsePrograms(nil)
+	virus := MakeDNA(VIRUS_RNA, "Program Virus")
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	cell := MakeCellFromType(CellType_Pneumocyte, WorkType_exchange, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	if diagram := virus.makeFunction(cell, virus); diagram.root.next != diagram.root {
+		t.Error("Expected the virus to run the program named after it")
+	}
+}
+
+func TestInvalidPrograms(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown action":    `{"programs": {"Neuron": [{"action": "Sing"}]}}`,
+		"unknown call":      `{"programs": {"Neuron": [{"call": "sing"}]}}`,
+		"call cycle":        `{"programs": {"a": [{"call": "b"}], "b": [{"call": "a"}]}}`,
+		"unknown label":     `{"programs": {"Neuron": [{"action": "Respirate", "goto": "nowhere"}]}}`,
+		"duplicate label":   `{"programs": {"Neuron": [{"label": "a", "action": "Respirate"}, {"label": "a", "action": "Respirate"}]}}`,
+		"unknown measure":   `{"programs": {"Neuron": [{"label": "a", "if": "mood > 1", "goto": "a"}]}}`,
+		"unknown op":        `{"programs": {"Neuron": [{"label": "a", "if": "damage ~ 1", "goto": "a"}]}}`,
+		"condition only":    `{"programs": {"Neuron": [{"action": "Respirate", "if": "damage > 1"}]}}`,
+		"empty step":        `{"programs": {"Neuron": [{}]}}`,
+		"empty program":     `{"programs": {"Neuron": []}}`,
+		"act and call":      `{"programs": {"Neuron": [{"action": "Respirate", "call": "work"}]}}`,
+		"incapable":         `{"programs": {"Hemocytoblast": [{"action": "DoWork"}]}}`,
+		"incapable in call": `{"programs": {"BLymphocyte": [{"call": "work"}]}}`,
+	} {
+		if _, err := ParsePrograms([]byte(data)); err == nil {
+			t.Errorf("%v: expected an error", name)
+		} else if !strings.HasPrefix(err.Error(), "programs: ") {
+			t.Errorf("%v: expected a programs error, got: %v", name, err)
+		}
+	}
+}
+
+func TestUsePrograms(t *testing.T) {
+	programs, err := ParsePrograms([]byte(`{"programs": {"Podocyte": [{"action": "DoWork"}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	UsePrograms(programs)
+	defer UsePrograms(nil)
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	cell := MakeCellFromType(CellType_Podocyte, WorkType_filter, human, &Renderable{}, time.Unix(1000, 0), [10]string{}, [10]string{}, nil, unownedRand)
+	if diagram := human.makeFunction(cell, human); diagram.root.next != diagram.root {
+		t.Error("Expected new cells to run the programs in use")
+	}
+	UsePrograms(nil)
+	if diagram := human.makeFunction(cell, human); diagram.root.next == diagram.root {
+		t.Error("Expected new cells to run the default programs again")
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"math"
 	"math/rand"
 	"sync"
-	"time"
 )
 
 type StateDiagram struct {
@@ -29,9 +28,15 @@ func (s *StateDiagram) Run(ctx context.Context, cell CellActor) (err error) {
 		}
 	}()
 	defer cancel()
-	ticker := time.NewTicker(CELL_CLOCK_RATE)
+	ticker := cell.Clock().NewTicker(ctx, CELL_CLOCK_RATE)
+	defer ticker.Stop()
 	if s.root != nil {
-		s.current = s.root
+		s.Lock()
+		if s.current == nil {
+			// Restored cells resume from their cursor.
+			s.current = s.root
+		}
+		s.Unlock()
 		for {
 			select {
 			case <-ctx.Done():
@@ -47,8 +52,9 @@ func (s *StateDiagram) Run(ctx context.Context, cell CellActor) (err error) {
 					} else {
 						cancel()
 					}
+					next := s.current.Next(ctx, cell)
 					s.Lock()
-					s.current = s.current.next
+					s.current = next
 					s.Unlock()
 				} else {
 					cancel()
@@ -80,9 +86,43 @@ func (s *StateDiagram) Graft(mutation *StateDiagram) {
 	cellLast.next = mutation.root
 }
 
+// Cursor counts the steps from the root to the current node.
+func (s *StateDiagram) Cursor() int {
+	s.RLock()
+	defer s.RUnlock()
+	seen := map[*StateNode]bool{}
+	cursor := 0
+	for node := s.root; node != nil && !seen[node]; node = node.next {
+		if node == s.current {
+			return cursor
+		}
+		seen[node] = true
+		cursor++
+	}
+	return 0
+}
+
+func (s *StateDiagram) SetCursor(cursor int) {
+	s.Lock()
+	defer s.Unlock()
+	s.current = s.root
+	for i := 0; i < cursor && s.current != nil; i++ {
+		s.current = s.current.next
+	}
+}
+
 type StateNode struct {
-	next     *StateNode
-	function *ProteinFunction
+	next      *StateNode
+	function  *ProteinFunction
+	jump      *StateNode // Taken instead of next if there's no condition or it holds.
+	condition *Condition
+}
+
+func (n *StateNode) Next(ctx context.Context, cell CellActor) *StateNode {
+	if n.jump != nil && (n.condition == nil || n.condition.Holds(ctx, cell)) {
+		return n.jump
+	}
+	return n.next
 }
 
 // Return false if terminal
@@ -139,14 +179,14 @@ func Explore(ctx context.Context, cell CellActor) bool {
 	}
 	isOpen := tissue.rootMatrix.GetOpenSpaces(points)
 	if len(isOpen) > 0 {
-		moveToPoint := isOpen[rand.Intn(len(isOpen))]
+		moveToPoint := isOpen[cell.Rand().Intn(len(isOpen))]
 		newPositions := cell.GetUnvisitedPositions(isOpen)
 		if len(newPositions) > 0 {
-			moveToPoint = newPositions[rand.Intn(len(newPositions))]
+			moveToPoint = newPositions[cell.Rand().Intn(len(newPositions))]
 		}
 		cell.MoveToPoint(moveToPoint)
 	} else {
-		cell.Move(1-rand.Intn(3), 1-rand.Intn(3), 0)
+		cell.Move(1-cell.Rand().Intn(3), 1-cell.Rand().Intn(3), 0)
 	}
 	return true
 }
@@ -179,6 +219,15 @@ func MoveTowardsAntigenPresentCytokineOrExplore(ctx context.Context, cell CellAc
 	return true
 }
 
+// Phagocytes follow anaphylatoxins released by complement, as well as
+// antigen present cytokines.
+func MoveTowardsPhagocyteCytokinesOrExplore(ctx context.Context, cell CellActor) bool {
+	if !cell.MoveTowardsCytokines([]CytokineType{CytokineType_anaphylatoxin, CytokineType_antigen_present}) {
+		return Explore(ctx, cell)
+	}
+	return true
+}
+
 func WillMitosisAndRepair(ctx context.Context, cell CellActor) bool {
 	// Not all cells can repair, but for the sake of this simulation, they can.
 	resource := cell.Organ().materialPool.GetResource(ctx)
@@ -228,7 +277,15 @@ func Apoptosis(ctx context.Context, cell CellActor) bool {
 	if cell.Verbose() {
 		fmt.Println(cell, " Died in", cell.Organ())
 	}
-	cell.Apoptosis(true)
+	cause := DAMAGE_CAUSE
+	if viralLoad := cell.ViralLoad(); viralLoad != nil {
+		viralLoad.RLock()
+		if viralLoad.concentration >= BURST_VIRUS_CONCENTRATION {
+			cause = VIRAL_BURST_CAUSE
+		}
+		viralLoad.RUnlock()
+	}
+	cell.Apoptosis(true, cause)
 	return false
 }
 
@@ -290,6 +347,135 @@ func Filtrate(ctx context.Context, cell CellActor) bool {
 	return true
 }
 
+func Detoxify(ctx context.Context, cell CellActor) bool {
+	// Remove some amount of ammonia.
+	request := cell.Organ().RequestWork(ctx, Work{
+		workType: WorkType_detoxify,
+	})
+	if request.status == 200 {
+		waste := cell.Organ().materialPool.GetWaste(ctx)
+		defer cell.Organ().materialPool.PutWaste(waste)
+		if waste.ammonia <= AMMONIA_DETOXIFY {
+			waste.ammonia = 0
+		} else {
+			waste.ammonia -= AMMONIA_DETOXIFY
+		}
+	}
+	return true
+}
+
+func RegulateGlucose(ctx context.Context, cell CellActor) bool {
+	// Have the liver store glucose on insulin, and release it on glucagon.
+	hormone := cell.Organ().materialPool.GetHormone(ctx)
+	store := hormone.insulin > INSULIN_STORE_THRESHOLD
+	release := hormone.glucagon >= GLUCAGON_RELEASE_THRESHOLD
+	if store {
+		hormone.insulin -= INSULIN_UPTAKE
+	} else if release {
+		hormone.glucagon -= GLUCAGON_RELEASE_THRESHOLD
+	}
+	cell.Organ().materialPool.PutHormone(hormone)
+	if store {
+		cell.Organ().RequestWork(ctx, Work{
+			workType: WorkType_glycogenesis,
+		})
+	} else if release {
+		cell.Organ().RequestWork(ctx, Work{
+			workType: WorkType_glycogenolysis,
+		})
+	}
+	return true
+}
+
+// Beta cells secrete insulin in proportion to the glucose they sense, so there
+// is always a little for cells to take up glucose with.
+func SecreteInsulin(ctx context.Context, cell CellActor) bool {
+	resource := cell.Organ().materialPool.GetResource(ctx)
+	glucose := resource.glucose
+	cell.Organ().materialPool.PutResource(resource)
+	if insulin := glucose / INSULIN_GLUCOSE_RATIO; insulin > 0 {
+		cell.Organ().materialPool.PutHormone(&HormoneBlob{
+			insulin: insulin,
+		})
+	}
+	return true
+}
+
+// Alpha cells secrete glucagon when glucose runs low.
+func SecreteGlucagon(ctx context.Context, cell CellActor) bool {
+	resource := cell.Organ().materialPool.GetResource(ctx)
+	glucose := resource.glucose
+	cell.Organ().materialPool.PutResource(resource)
+	if glucose < GLUCAGON_GLUCOSE_THRESHOLD {
+		cell.Organ().materialPool.PutHormone(&HormoneBlob{
+			glucagon: GLUCAGON_SECRETION,
+		})
+	}
+	return true
+}
+
+func ReplenishComplement(ctx context.Context, cell CellActor) bool {
+	hormone := cell.Organ().materialPool.GetHormone(ctx)
+	complement := hormone.complement
+	cell.Organ().materialPool.PutHormone(hormone)
+	if complement < COMPLEMENT_THRESHOLD {
+		cell.Organ().RequestWork(ctx, Work{
+			workType: WorkType_complement,
+		})
+	}
+	return true
+}
+
+// Complement binds to the bacterium's surface, faster when antibodies mark
+// it, releasing anaphylatoxins that draw phagocytes. Once it is covered,
+// membrane attack complexes punch holes in it.
+// https://www.ncbi.nlm.nih.gov/books/NBK27100/
+func FixComplement(ctx context.Context, cell CellActor) bool {
+	fixation := COMPLEMENT_FIXATION
+	if antibodyLoad := cell.AntibodyLoad(); antibodyLoad != nil && antibodyLoad.concentration > 0 {
+		fixation *= 2
+	}
+	fixed := cell.Organ().materialPool.FixComplement(ctx, fixation)
+	if fixed == 0 {
+		return true
+	}
+	cell.Opsonize(fixed)
+	cell.DropCytokine(CytokineType_anaphylatoxin, CYTOKINE_ANAPHYLATOXIN)
+	if cell.Opsonins() >= COMPLEMENT_MEMBRANE_ATTACK_THRESHOLD {
+		cell.IncurDamage(COMPLEMENT_MEMBRANE_ATTACK_DAMAGE)
+	}
+	return true
+}
+
+// Red blood cells don't divide. An aged one leaves for the spleen to be
+// retired, and the bone marrow the blood drains sends a new one in its place,
+// from the same stem cell. Blood with no marrow of its own makes the new one
+// where the old one was.
+func Senesce(ctx context.Context, cell CellActor) bool {
+	o := cell.Organ()
+	if !Senescent(cell) || InSpleen(o) {
+		return true
+	}
+	toSpleen := false
+	marrowUrl := o.transportUrl
+	for _, edge := range o.Edges() {
+		switch edge.edgeType {
+		case splenic:
+			toSpleen = true
+		case skeletal:
+			if edge.Healthy() {
+				marrowUrl = edge.transportUrl
+			}
+		}
+	}
+	renderId := string(cell.Render().id)
+	if !toSpleen || !Transport(cell) {
+		return true
+	}
+	o.MakeTransportRequest(marrowUrl, cell.DNA().name, cell.DNA(), cell.CellType(), cell.WorkType(), renderId, cell.Clock().Now(), [10]string{}, [10]string{}, map[Protein]bool{}, Lineage{Parent: cell.Lineage().Parent}, cell.Rand().Int63())
+	return false
+}
+
 func MuscleFindFood(ctx context.Context, cell CellActor) bool {
 	request := cell.Organ().RequestWork(ctx, Work{
 		workType: WorkType_think,
@@ -403,249 +589,20 @@ func ShouldTransport(ctx context.Context, cell CellActor) bool {
 	return true
 }
 
-func GenerateRandomProteinPermutation(dna *DNA) (proteins []Protein) {
+func GenerateRandomProteinPermutation(random *rand.Rand, dna *DNA) (proteins []Protein) {
 	selfProteins := dna.selfProteins
 	chooseN := len(selfProteins) / 3
-	permutations := rand.Perm(chooseN)
+	permutations := random.Perm(chooseN)
 	for i := 0; i < chooseN; i++ {
 		proteins = append(proteins, selfProteins[permutations[i]])
 	}
 	return
 }
 
-func MakeStateDiagramByEukaryote(c CellActor, dna *DNA) *StateDiagram {
-	s := &StateDiagram{
-		root: &StateNode{
-			function: &ProteinFunction{
-				action:   WillMitosisAndRepair,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		},
-	}
-	currNode := s.root
-	switch c.CellType() {
-	case CellType_Pneumocyte:
-		fallthrough
-	case CellType_Keratinocyte:
-		fallthrough
-	case CellType_Podocyte:
-		fallthrough
-	case CellType_Hemocytoblast:
-		fallthrough
-	case CellType_Lymphoblast:
-		fallthrough
-	case CellType_Myeloblast:
-		fallthrough
-	case CellType_Monocyte:
-		fallthrough
-	case CellType_Macrophagocyte:
-		fallthrough
-	case CellType_Neutrocyte:
-		fallthrough
-	case CellType_NaturalKillerCell:
-		fallthrough
-	case CellType_VirginTLymphocyte:
-		fallthrough
-	case CellType_HelperTLymphocyte:
-		fallthrough
-	case CellType_KillerTLymphocyte:
-		fallthrough
-	case CellType_BLymphocyte:
-		fallthrough
-	case CellType_EffectorBLymphocyte:
-		fallthrough
-	case CellType_Dendritic:
-		// Do nothing special.
-	case CellType_RedBlood:
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Expirate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Filtrate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	case CellType_Neuron:
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   BrainRequestPump,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Respirate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   BrainStimulateMuscles,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Respirate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	case CellType_Enterocyte:
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Flatulate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		for i := 3; i > 0; i-- {
-			currNode = currNode.next
-			// Larger demand for oxygen to supply gut bacteria.
-			currNode.next = &StateNode{
-				function: &ProteinFunction{
-					action:   Respirate,
-					proteins: GenerateRandomProteinPermutation(dna),
-				},
-			}
-		}
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   CheckVitaminLevels,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	case CellType_Myocyte:
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   MuscleFindFood,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   MuscleSeekSkinProtection,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-		fallthrough
-	case CellType_Cardiomyocyte:
-		fallthrough
-	default:
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Respirate,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	if c.CanMove() {
-		switch c.CellType() {
-		case CellType_VirginTLymphocyte:
-			fallthrough
-		case CellType_BLymphocyte:
-			currNode.next = &StateNode{
-				function: &ProteinFunction{
-					action:   MoveTowardsChemotaxisCytokineOrExplore,
-					proteins: GenerateRandomProteinPermutation(dna),
-				},
-			}
-			currNode = currNode.next
-		case CellType_Neutrocyte:
-			fallthrough
-		case CellType_Macrophagocyte:
-			fallthrough
-		case CellType_Dendritic:
-			fallthrough
-		case CellType_HelperTLymphocyte:
-			currNode.next = &StateNode{
-				function: &ProteinFunction{
-					action:   MoveTowardsAntigenPresentCytokineOrExplore,
-					proteins: GenerateRandomProteinPermutation(dna),
-				},
-			}
-			currNode = currNode.next
-		case CellType_NaturalKillerCell:
-			fallthrough
-		case CellType_KillerTLymphocyte:
-			currNode.next = &StateNode{
-				function: &ProteinFunction{
-					action:   MoveTowardsCellStressCytokineOrExplore,
-					proteins: GenerateRandomProteinPermutation(dna),
-				},
-			}
-			currNode = currNode.next
-		case CellType_Lymphoblast:
-			fallthrough
-		case CellType_Myeloblast:
-			fallthrough
-		case CellType_Monocyte:
-			fallthrough
-		case CellType_EffectorBLymphocyte:
-			fallthrough
-		default:
-			currNode.next = &StateNode{
-				function: &ProteinFunction{
-					action:   Explore,
-					proteins: GenerateRandomProteinPermutation(dna),
-				},
-			}
-			currNode = currNode.next
-		}
-	}
-	if c.CanInteract() {
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   Interact,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	if c.DoesWork() {
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   DoWork,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	if c.CanTransport() {
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   ShouldTransport,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	currNode.next = &StateNode{
-		next: s.root, // Loop back.
-		function: &ProteinFunction{
-			action:   ShouldApoptosis,
-			proteins: GenerateRandomProteinPermutation(dna),
-		},
-	}
-	return s
-}
-
 // Bacteria Related CellActions
 
 func BacteriaMoveAwayFromCytokinesOrExplore(ctx context.Context, cell CellActor) bool {
-	if rand.Intn(5) == 0 {
+	if cell.Rand().Intn(5) == 0 {
 		return Explore(ctx, cell)
 	} else if !cell.MoveAwayFromCytokines([]CytokineType{CytokineType_cytotoxins}) {
 		return Explore(ctx, cell)
@@ -692,51 +649,6 @@ func BacteriaConsume(ctx context.Context, cell CellActor) bool {
 	return true
 }
 
-func MakeStateDiagramByProkaryote(c CellActor, dna *DNA) *StateDiagram {
-	s := &StateDiagram{
-		root: &StateNode{
-			function: &ProteinFunction{
-				action:   BacteriaWillMitosis,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		},
-	}
-	currNode := s.root
-	currNode.next = &StateNode{
-		function: &ProteinFunction{
-			action:   BacteriaConsume,
-			proteins: GenerateRandomProteinPermutation(dna),
-		},
-	}
-	currNode = currNode.next
-	if c.CanMove() {
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   BacteriaMoveAwayFromCytokinesOrExplore,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	if c.CanTransport() {
-		currNode.next = &StateNode{
-			function: &ProteinFunction{
-				action:   ShouldTransport,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		}
-		currNode = currNode.next
-	}
-	currNode.next = &StateNode{
-		next: s.root, // Back to beginning.
-		function: &ProteinFunction{
-			action:   ShouldApoptosis,
-			proteins: GenerateRandomProteinPermutation(dna),
-		},
-	}
-	return s
-}
-
 // Virus StateDiagrams
 
 func MakeVirusProtein(ctx context.Context, cell CellActor) bool {
@@ -768,23 +680,3 @@ func ProduceInterferon(ctx context.Context, cell CellActor) bool {
 	}
 	return true
 }
-
-func MakeStateDiagramByVirus(c CellActor, dna *DNA) *StateDiagram {
-	s := &StateDiagram{
-		root: &StateNode{
-			function: &ProteinFunction{
-				action:   MakeVirusProtein,
-				proteins: GenerateRandomProteinPermutation(dna),
-			},
-		},
-	}
-	currNode := s.root
-	currNode.next = &StateNode{
-		function: &ProteinFunction{
-			action:   ProduceInterferon,
-			proteins: GenerateRandomProteinPermutation(dna),
-		},
-		next: s.root,
-	}
-	return s
-}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Every random number in the simulation is drawn from a stream derived from a
+// single seed. Nodes, cells and DNA each get their own stream, so a run with
+// the same seed draws the same numbers in each stream.
+var seed = time.Now().UnixNano()
+var streamCounts = map[string]int{}
+var streamMutex sync.Mutex
+
+// For randomness that no seed could replay anyway, like the render IDs of
+// nanobots that users log in, or cells made outside of a node.
+var unownedRand = MakeRand("unowned")
+
+func Seed() int64 {
+	streamMutex.Lock()
+	defer streamMutex.Unlock()
+	return seed
+}
+
+// SetSeed must be called before the body is generated.
+func SetSeed(s int64) {
+	streamMutex.Lock()
+	seed = s
+	streamCounts = map[string]int{}
+	streamMutex.Unlock()
+	unownedRand = MakeRand("unowned")
+}
+
+// MakeRand returns the next stream for the given name. The nth stream made
+// for a name is always the same for a given seed.
+func MakeRand(name string) *rand.Rand {
+	streamMutex.Lock()
+	defer streamMutex.Unlock()
+	count := streamCounts[name]
+	streamCounts[name]++
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v/%v/%v", seed, name, count)))
+	return MakeLockedRand(int64(binary.LittleEndian.Uint64(hash[:])))
+}
+
+// Streams are shared between goroutines, so guard the source. Read isn't
+// safe to share, since rand.Rand buffers it outside of the source.
+func MakeLockedRand(s int64) *rand.Rand {
+	return rand.New(&lockedSource{
+		source: rand.NewSource(s).(rand.Source64),
+	})
+}
+
+type lockedSource struct {
+	sync.Mutex
+	source rand.Source64
+}
+
+func (l *lockedSource) Int63() int64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.source.Int63()
+}
+
+func (l *lockedSource) Uint64() uint64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.source.Uint64()
+}
+
+func (l *lockedSource) Seed(s int64) {
+	l.Lock()
+	defer l.Unlock()
+	l.source.Seed(s)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+)
+
+type NodeAnnouncement struct {
+	Name    string `json:"name"`
+	Address string `json:"address"` // The node's transport URL.
+}
+
+// Every process hosting part of a body must draw from the same seed, or their
+// human DNA won't match and cells become foreign when they cross over.
+type RegistryListing struct {
+	Seed  int64             `json:"seed"`
+	Nodes map[string]string `json:"nodes"`
+}
+
+// NodeDirectory is where nodes announce their addresses and look up their
+// neighbors'.
+type NodeDirectory interface {
+	Announce(name, address string) error
+	Listing() (RegistryListing, error)
+}
+
+// NodeRegistry is served by the main process, so that `efflux node` processes
+// can find the rest of the body.
+type NodeRegistry struct {
+	sync.RWMutex
+	seed      int64
+	addresses map[string]string
+}
+
+func InitializeNodeRegistry(seed int64) *NodeRegistry {
+	return &NodeRegistry{
+		seed:      seed,
+		addresses: map[string]string{},
+	}
+}
+
+func (r *NodeRegistry) Announce(name, address string) error {
+	if name == "" || address == "" {
+		return fmt.Errorf("registry: announcement needs a name and an address")
+	}
+	r.Lock()
+	defer r.Unlock()
+	if existing, ok := r.addresses[name]; ok && existing != address {
+		return fmt.Errorf("registry: node %q is already at %v", name, existing)
+	}
+	r.addresses[name] = address
+	return nil
+}
+
+func (r *NodeRegistry) Listing() (RegistryListing, error) {
+	r.RLock()
+	defer r.RUnlock()
+	listing := RegistryListing{
+		Seed:  r.seed,
+		Nodes: map[string]string{},
+	}
+	for name, address := range r.addresses {
+		listing.Nodes[name] = address
+	}
+	return listing, nil
+}
+
+// HandleRegistryRequest lists the seed and every announced node on GET, and
+// announces a node POSTed as {"name": ..., "address": ...}.
+func (r *NodeRegistry) HandleRegistryRequest(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		listing, _ := r.Listing()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(listing)
+	case http.MethodPost:
+		var announcement NodeAnnouncement
+		if err := json.NewDecoder(req.Body).Decode(&announcement); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := r.Announce(announcement.Name, announcement.Address); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		fmt.Println("Registered", announcement.Name, "at", announcement.Address)
+	default:
+		http.Error(w, "The registry accepts GET and POST.", http.StatusMethodNotAllowed)
+	}
+}
+
+// RegistryClient reaches a NodeRegistry served by another process.
+type RegistryClient struct {
+	url    string
+	client *http.Client
+}
+
+func InitializeRegistryClient(url string) *RegistryClient {
+	return &RegistryClient{
+		url:    url,
+		client: &http.Client{Timeout: REGISTRY_TIMEOUT},
+	}
+}
+
+func (c *RegistryClient) Announce(name, address string) error {
+	data, err := json.Marshal(NodeAnnouncement{
+		Name:    name,
+		Address: address,
+	})
+	if err != nil {
+		return fmt.Errorf("registry: %w", err)
+	}
+	response, err := c.client.Post(c.url, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("registry: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry: announcing %q: %v", name, response.Status)
+	}
+	return nil
+}
+
+func (c *RegistryClient) Listing() (listing RegistryListing, err error) {
+	response, err := c.client.Get(c.url)
+	if err != nil {
+		return listing, fmt.Errorf("registry: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return listing, fmt.Errorf("registry: %v", response.Status)
+	}
+	err = json.NewDecoder(response.Body).Decode(&listing)
+	if err != nil {
+		return listing, fmt.Errorf("registry: %w", err)
+	}
+	return listing, nil
+}
+
+// WaitForListing retries until the directory is reachable and, if name is
+// set, until that node has announced itself.
+func WaitForListing(ctx context.Context, directory NodeDirectory, name string) (RegistryListing, error) {
+	ctx, cancel := context.WithTimeout(ctx, REGISTRY_TIMEOUT)
+	defer cancel()
+	ticker := time.NewTicker(REGISTRY_POLL_INTERVAL)
+	defer ticker.Stop()
+	for {
+		listing, err := directory.Listing()
+		if err == nil {
+			if _, ok := listing.Nodes[name]; name == "" || ok {
+				return listing, nil
+			}
+			err = fmt.Errorf("registry: node %q has not been announced", name)
+		}
+		select {
+		case <-ctx.Done():
+			return listing, err
+		case <-ticker.C:
+		}
+	}
+}
+
+func LookupNode(ctx context.Context, directory NodeDirectory, name string) (string, error) {
+	listing, err := WaitForListing(ctx, directory, name)
+	if err != nil {
+		return "", err
+	}
+	return listing.Nodes[name], nil
+}
+
+func ParseNodeNames(names string) (hosted map[string]bool) {
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if hosted == nil {
+			hosted = map[string]bool{}
+		}
+		hosted[name] = true
+	}
+	return
+}
+
+// RunNodeCommand implements `efflux node`, which hosts some of a body's nodes
+// and connects them to the rest through the registry of the main process.
+func RunNodeCommand(args []string) error {
+	flags := flag.NewFlagSet("node", flag.ContinueOnError)
+	registryUrl := flags.String("registry", DEFAULT_REGISTRY_URL, "URL of the registry served by the main process.")
+	nodeNames := flags.String("nodes", "", "Comma separated names of the nodes to host.")
+	bodyPath := flags.String("body", "", "Path to the anatomy JSON file the main process was given.")
+	scenarioPath := flags.String("scenario", "", "Path to the infection scenario to run in the hosted nodes.")
+	programsPath := flags.String("programs", "", "Path to the cell programs the main process was given.")
+	eventsPath := flags.String("events", "", "Path to append the JSONL event log to.")
+	host := flags.String("host", "localhost", "Host other processes reach these nodes at.")
+	port := flags.Int("port", 9000, "Port of the first hosted node, the rest follow.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	hosted := ParseNodeNames(*nodeNames)
+	if hosted == nil {
+		return fmt.Errorf("node: -nodes is required")
+	}
+	var err error
+	anatomy := DefaultAnatomy()
+	if *bodyPath != "" {
+		anatomy, err = LoadAnatomy(*bodyPath)
+		if err != nil {
+			return err
+		}
+	}
+	scenario := DefaultScenario()
+	if *scenarioPath != "" {
+		scenario, err = LoadScenario(*scenarioPath)
+		if err != nil {
+			return err
+		}
+	}
+	if *programsPath != "" {
+		programs, err := LoadPrograms(*programsPath)
+		if err != nil {
+			return err
+		}
+		UsePrograms(programs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt)
+	defer signal.Stop(signalChan)
+
+	registry := InitializeRegistryClient(*registryUrl)
+	listing, err := WaitForListing(ctx, registry, "")
+	if err != nil {
+		return err
+	}
+	SetSeed(listing.Seed)
+	fmt.Println("Seed:", Seed())
+	SetAddresses(*host, *port)
+	transport := InitializeHTTPTransport()
+	body, err := InitializeBodyPart(ctx, anatomy, transport, hosted, registry)
+	if err != nil {
+		return err
+	}
+	if *eventsPath != "" {
+		eventsFile, err := os.OpenFile(*eventsPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		defer eventsFile.Close()
+		body.events.SetWriter(eventsFile)
+	}
+	body.GenerateCellsAndStart(ctx, anatomy)
+	err = body.RunScenario(ctx, scenario)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-signalChan:
+		return nil
+	case err := <-transport.Errors():
+		return err
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistryClient(t *testing.T) {
+	registry := InitializeNodeRegistry(1234)
+	server := httptest.NewServer(http.HandlerFunc(registry.HandleRegistryRequest))
+	defer server.Close()
+	client := InitializeRegistryClient(server.URL)
+
+	if err := client.Announce("Heart", "http://heart:8000/transport"); err != nil {
+		t.Fatal(err)
+	}
+	// Announcing again from the same address is fine, but not from another.
+	if err := client.Announce("Heart", "http://heart:8000/transport"); err != nil {
+		t.Error(err)
+	}
+	if err := client.Announce("Heart", "http://elsewhere:8000/transport"); err == nil {
+		t.Error("Expected an error announcing a node at a second address")
+	}
+	if err := client.Announce("", "http://heart:8000/transport"); err == nil {
+		t.Error("Expected an error announcing a node without a name")
+	}
+	listing, err := client.Listing()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listing.Seed != 1234 || len(listing.Nodes) != 1 || listing.Nodes["Heart"] != "http://heart:8000/transport" {
+		t.Errorf("Expected seed 1234 and the heart, got: %+v", listing)
+	}
+	address, err := LookupNode(context.Background(), client, "Heart")
+	if err != nil || address != "http://heart:8000/transport" {
+		t.Errorf("Expected the heart's address, got: %q, %v", address, err)
+	}
+}
+
+func TestBodyPart(t *testing.T) {
+	anatomy, err := ParseAnatomy([]byte(`{
+		"nodes": [{"name": "Part Heart", "organ": "heart"}, {"name": "Part Lung", "organ": "lung"}],
+		"edges": [{"node1": "Part Heart", "node2": "Part Lung", "toNode2": "cardiovascular", "toNode1": "cardiovascular"}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	transport := InitializeMemoryTransport()
+	registry := InitializeNodeRegistry(Seed())
+
+	if _, err := InitializeBodyPart(ctx, anatomy, transport, map[string]bool{"Part Liver": true}, registry); err == nil {
+		t.Error("Expected an error hosting an unknown node")
+	}
+
+	// Each part waits for the other to announce itself before connecting.
+	lungPart := make(chan *Body)
+	go func() {
+		body, err := InitializeBodyPart(ctx, anatomy, transport, map[string]bool{"Part Lung": true}, registry)
+		if err != nil {
+			t.Error(err)
+		}
+		lungPart <- body
+	}()
+	heartPart, err := InitializeBodyPart(ctx, anatomy, transport, map[string]bool{"Part Heart": true}, registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lungBody := <-lungPart
+	if lungBody == nil {
+		t.FailNow()
+	}
+	heart, lung := heartPart.FindNode("Part Heart"), lungBody.FindNode("Part Lung")
+	if heartPart.FindNode("Part Lung") != nil || lung == nil || !heartPart.remote["Part Lung"] {
+		t.Fatal("Expected each part to host only its own node")
+	}
+	if len(heart.edges) != 1 || heart.edges[0].transportUrl != lung.transportUrl {
+		t.Errorf("Expected the heart to connect to the lung, got: %v", heart.edges)
+	}
+	err = heart.MakeTransportRequest(heart.edges[0].transportUrl, HUMAN_NAME, MakeDNA(HUMAN_DNA, HUMAN_NAME), CellType_RedBlood, WorkType_exchange, "", heartPart.clock.Now(), [10]string{}, [10]string{}, nil, Lineage{}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lung.Cells()) != 1 {
+		t.Errorf("Expected the cell to reach the lung, got %v cells", len(lung.Cells()))
+	}
+
+	// The admin endpoints of a part forward requests for the other part's
+	// nodes to it.
+	mux := http.NewServeMux()
+	heartPart.RegisterAdminEndpoints(ctx, mux)
+	request := func(method, target, data string) int {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, target, strings.NewReader(data)))
+		return w.Code
+	}
+	vaccinations, unsubscribe := lungBody.events.Subscribe(EventFilter{Type: VACCINATION_EVENT, Node: "Part Lung"})
+	defer unsubscribe()
+	if code := request(http.MethodPost, ADMIN_VACCINATE_ENDPOINT, `{"node": "Part Lung", "pathogen": "Part Flu", "kind": "virus", "targetCellType": "Pneumocyte", "dose": 1}`); code != http.StatusOK {
+		t.Errorf("Expected to vaccinate a node in another process, got: %v", code)
+	}
+	select {
+	case <-vaccinations:
+	case <-time.After(5 * time.Second):
+		t.Error("Expected the vaccination to reach the lung's process")
+	}
+	if code := request(http.MethodPost, ADMIN_SCENARIO_ENDPOINT, `{"exposures": [{"node": "Part Lung", "pathogen": "Part Strep", "kind": "bacteria", "dose": 1}]}`); code != http.StatusOK {
+		t.Errorf("Expected to run a scenario in another process, got: %v", code)
+	}
+	if code := request(http.MethodPost, ADMIN_VACCINATE_ENDPOINT, `{"node": "Part Heart", "pathogen": "Part Flu", "kind": "virus", "targetCellType": "Pneumocyte", "dose": 1}`); code != http.StatusOK {
+		t.Errorf("Expected to vaccinate the part's own node, got: %v", code)
+	}
+	if code := request(http.MethodPost, ADMIN_CLOCK_ENDPOINT, `{"action": "pause"}`); code != http.StatusOK {
+		t.Errorf("Expected to pause a body split across processes, got: %v", code)
+	}
+	if code := request(http.MethodPost, ADMIN_CLOCK_ENDPOINT, `{"action": "step", "duration": "1m"}`); code != http.StatusOK {
+		t.Errorf("Expected to step a body split across processes, got: %v", code)
+	}
+	lungClock, heartClock := lungBody.clock.Status(), heartPart.clock.Status()
+	if !lungClock.Now.Equal(heartClock.Now) || !lungClock.Paused || lungClock.Speed != heartClock.Speed {
+		t.Errorf("Expected the lung's process to keep the heart's clock, got: %+v, %+v", lungClock, heartClock)
+	}
+	delete(registry.addresses, "Part Lung")
+	if code := request(http.MethodPost, ADMIN_CLOCK_ENDPOINT, `{"action": "resume"}`); code != http.StatusBadGateway {
+		t.Errorf("Expected a 502 when the other process can't be reached, got: %v", code)
+	}
+	if code := request(http.MethodGet, ADMIN_CLOCK_ENDPOINT, ""); code != http.StatusOK {
+		t.Errorf("Expected the part's clock to be readable, got: %v", code)
+	}
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+//go:embed scenarios/pneumonia.json
+var defaultScenario []byte
+
+type PathogenKind string
+
+const (
+	BACTERIA_PATHOGEN PathogenKind = "bacteria"
+	VIRUS_PATHOGEN    PathogenKind = "virus"
+)
+
+// Duration reads as a Go duration string, like "90s" or "5m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// What an exposure delivers: the live pathogen by default, an inactivated
+// antigen made from its DNA, or ready-made antibodies against it.
+type ExposureAction string
+
+const (
+	INFECT_ACTION    ExposureAction = "infect"
+	VACCINATE_ACTION ExposureAction = "vaccinate"
+	IMMUNIZE_ACTION  ExposureAction = "immunize"
+)
+
+// A Scenario is a list of exposures to pathogens. Exposures to the same
+// pathogen name share the same DNA, so a repeated exposure is a reinfection,
+// unless a virus targets another cell type.
+type Scenario struct {
+	Exposures []Exposure `json:"exposures"`
+}
+
+type Exposure struct {
+	Action         ExposureAction `json:"action"` // Defaults to infect.
+	Pathogen       string         `json:"pathogen"`
+	Kind           PathogenKind   `json:"kind"`
+	TargetCellType string         `json:"targetCellType"` // Viruses only.
+	Node           string         `json:"node"`
+	Dose           int            `json:"dose"`    // Pathogens, antigen units or antibody concentration.
+	Protein        int            `json:"protein"` // Immunize only: which of the pathogen's proteins, from 0.
+	Offset         Duration       `json:"offset"`  // Delay from when the scenario is run.
+}
+
+func (e Exposure) String() string {
+	if e.Action != "" && e.Action != INFECT_ACTION {
+		return fmt.Sprintf("%v %v x%v (%v) in %v", e.Action, e.Pathogen, e.Dose, e.Kind, e.Node)
+	}
+	return fmt.Sprintf("%v x%v (%v) in %v", e.Pathogen, e.Dose, e.Kind, e.Node)
+}
+
+func ParseScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+	err := json.Unmarshal(data, scenario)
+	if err != nil {
+		return nil, fmt.Errorf("scenario: %w", err)
+	}
+	err = scenario.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scenario: %w", err)
+	}
+	return ParseScenario(data)
+}
+
+func DefaultScenario() *Scenario {
+	scenario, err := ParseScenario(defaultScenario)
+	if err != nil {
+		panic(err)
+	}
+	return scenario
+}
+
+func (s *Scenario) Validate() error {
+	for _, exposure := range s.Exposures {
+		if exposure.Pathogen == "" {
+			return fmt.Errorf("exposure is missing a pathogen name")
+		}
+		if exposure.Dose < 0 || exposure.Offset < 0 || exposure.Protein < 0 {
+			return fmt.Errorf("exposure %v must have a non-negative dose, offset and protein", exposure)
+		}
+		switch exposure.Action {
+		case IMMUNIZE_ACTION:
+		case "", INFECT_ACTION, VACCINATE_ACTION:
+			if exposure.Protein != 0 {
+				return fmt.Errorf("exposure %v: only immunize targets a protein", exposure)
+			}
+		default:
+			return fmt.Errorf("exposure %v has unknown action: %q", exposure, exposure.Action)
+		}
+		switch exposure.Kind {
+		case BACTERIA_PATHOGEN:
+		case VIRUS_PATHOGEN:
+			targetCellType, err := ParseCellType(exposure.TargetCellType)
+			if err != nil {
+				return fmt.Errorf("exposure %v: %w", exposure, err)
+			}
+			if !LookupCellType(targetCellType).Infectable {
+				return fmt.Errorf("exposure %v: viruses can't target %v", exposure, targetCellType)
+			}
+		default:
+			return fmt.Errorf("exposure %v has unknown kind: %q", exposure, exposure.Kind)
+		}
+	}
+	return nil
+}
+
+type PathogenRegistry struct {
+	sync.Mutex
+	pathogens map[string]*DNA
+}
+
+func (r *PathogenRegistry) GetDNA(exposure Exposure) *DNA {
+	r.Lock()
+	defer r.Unlock()
+	if r.pathogens == nil {
+		r.pathogens = map[string]*DNA{}
+	}
+	key := fmt.Sprintf("%v/%v", exposure.Kind, exposure.Pathogen)
+	if exposure.Kind == VIRUS_PATHOGEN {
+		// A virus's DNA decides what it infects, so one targeting another
+		// cell type is another virus, even under the same name.
+		key += "/" + exposure.TargetCellType
+	}
+	dna, ok := r.pathogens[key]
+	if !ok {
+		switch exposure.Kind {
+		case VIRUS_PATHOGEN:
+			targetCellType, _ := ParseCellType(exposure.TargetCellType)
+			dna = MakeVirusDNA(exposure.Pathogen, targetCellType)
+		default:
+			dna = MakeDNA(BACTERIA_DNA, exposure.Pathogen)
+		}
+		r.pathogens[key] = dna
+	}
+	return dna
+}
+
+func (b *Body) RunScenario(ctx context.Context, scenario *Scenario) error {
+	for _, exposure := range scenario.Exposures {
+		if b.FindNode(exposure.Node) == nil && !b.remote[exposure.Node] {
+			return fmt.Errorf("exposure %v: unknown node", exposure)
+		}
+		// How many proteins a pathogen has depends on its DNA.
+		if exposure.Action == IMMUNIZE_ACTION {
+			proteins := b.pathogens.GetDNA(exposure).selfProteins
+			if exposure.Protein >= len(proteins) {
+				return fmt.Errorf("exposure %v: protein %v is out of range, %v has %v", exposure, exposure.Protein, exposure.Pathogen, len(proteins))
+			}
+		}
+	}
+	start := b.clock.Now()
+	for _, exposure := range scenario.Exposures {
+		go func(exposure Exposure) {
+			if b.clock.WaitUntil(ctx, start.Add(time.Duration(exposure.Offset))) {
+				b.Expose(ctx, exposure)
+			}
+		}(exposure)
+	}
+	return nil
+}
+
+// ForwardExposures hands exposures in nodes hosted by other processes on to
+// them, since RunScenario only runs those hosted here.
+func (b *Body) ForwardExposures(scenario *Scenario) error {
+	for _, exposure := range scenario.Exposures {
+		if !b.remote[exposure.Node] {
+			continue
+		}
+		exposure := exposure
+		err := b.forward(exposure.Node, &ControlRequest{Exposure: &exposure})
+		if err != nil {
+			return fmt.Errorf("exposure %v: %w", exposure, err)
+		}
+	}
+	return nil
+}
+
+func (b *Body) Expose(ctx context.Context, exposure Exposure) {
+	node := b.FindNode(exposure.Node)
+	if node == nil {
+		// The process hosting the node runs its own exposures, or is
+		// forwarded them.
+		return
+	}
+	dna := b.pathogens.GetDNA(exposure)
+	switch exposure.Action {
+	case VACCINATE_ACTION:
+		antigen := &InactivatedAntigen{dna: dna, dose: exposure.Dose}
+		antigen.Deliver(ctx, node)
+		node.LogEvent(Event{Type: VACCINATION_EVENT, Target: exposure.Pathogen, Detail: fmt.Sprint(exposure.Dose)})
+	case IMMUNIZE_ACTION:
+		// Passive immunization against one of the pathogen's proteins, with
+		// fully matured IgG like a monoclonal antibody.
+		protein := dna.selfProteins[exposure.Protein]
+		immunoglobulin := &Immunoglobulin{isotype: AntibodyIsotype_IgG, affinity: ANTIBODY_MAX_AFFINITY}
+		node.antigenPool.DepositAntibodyLoad(immunoglobulin.Secrete(protein, int64(exposure.Dose)))
+		node.LogEvent(Event{Type: ANTIBODY_EVENT, Target: fmt.Sprint(protein), Detail: fmt.Sprintf("%v %v, affinity %v", exposure.Dose, immunoglobulin.isotype, immunoglobulin.affinity)})
+	default:
+		cellType := CellType_Bacteria
+		if exposure.Kind == VIRUS_PATHOGEN {
+			cellType = CellType_ViralLoadCarrier
+		}
+		node.LogEvent(Event{Type: EXPOSURE_EVENT, Target: exposure.Pathogen, Detail: fmt.Sprint(exposure.Dose)})
+		for i := 0; i < exposure.Dose; i++ {
+			err := node.MakeTransportRequest(node.transportUrl, exposure.Pathogen, dna, cellType, WorkType_nothing, "", b.clock.Now(), [10]string{}, [10]string{}, nil, Lineage{}, node.exposureRand.Int63())
+			if err != nil {
+				fmt.Println("Unable to expose", node, "to", exposure.Pathogen, err)
+			}
+		}
+	}
+}
+
+func (b *Body) HandleScenarioRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Scenarios must be POSTed.", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	scenario, err := ParseScenario(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = b.RunScenario(ctx, scenario)
+	if err == nil {
+		err = b.ForwardExposures(scenario)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), scenarioErrorStatus(err))
+		return
+	}
+	fmt.Fprintf(w, "Scheduled %v exposures", len(scenario.Exposures))
+}
+
+// HandleExposureRequest runs a single POSTed exposure with the given action,
+// like a vaccine shot or an antibody infusion.
+func (b *Body) HandleExposureRequest(ctx context.Context, action ExposureAction, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Exposures must be POSTed.", http.StatusMethodNotAllowed)
+		return
+	}
+	exposure := Exposure{}
+	err := json.NewDecoder(r.Body).Decode(&exposure)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	exposure.Action = action
+	scenario := &Scenario{Exposures: []Exposure{exposure}}
+	err = scenario.Validate()
+	if err == nil {
+		err = b.RunScenario(ctx, scenario)
+	}
+	if err == nil {
+		err = b.ForwardExposures(scenario)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), scenarioErrorStatus(err))
+		return
+	}
+	fmt.Fprintf(w, "Scheduled %v", exposure)
+}
+
+// Exposures that couldn't reach the process hosting their node failed there,
+// rather than being malformed.
+func scenarioErrorStatus(err error) int {
+	var remoteNodeError *RemoteNodeError
+	if errors.As(err, &remoteNodeError) {
+		return http.StatusBadGateway
+	}
+	return http.StatusBadRequest
+}
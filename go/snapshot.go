@@ -0,0 +1,349 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DNAIndex stores each distinct DNA once per snapshot, so cells refer to
+// their DNA by index. Transport requests only reference DNA by ID, and leave
+// it to the transport to send it in full.
+type DNAIndex struct {
+	index      map[string]int32
+	dna        []*DNASnapshot
+	references bool
+	err        error // The first DNA that couldn't be recorded in full.
+}
+
+func (d *DNAIndex) Add(dna *DNA) int32 {
+	if d.index == nil {
+		d.index = map[string]int32{}
+	}
+	i, ok := d.index[dna.id]
+	if !ok {
+		snapshot := &DNASnapshot{Id: dna.id}
+		if !d.references {
+			full, err := dna.Snapshot()
+			if err == nil {
+				snapshot = full
+			} else if d.err == nil {
+				d.err = fmt.Errorf("dna %v: %w", dna.name, err)
+			}
+		}
+		i = int32(len(d.dna))
+		d.index[dna.id] = i
+		d.dna = append(d.dna, snapshot)
+	}
+	return i
+}
+
+// Err reports a DNA that couldn't be recorded, which leaves every cell made
+// from it unusable.
+func (d *DNAIndex) Err() error {
+	return d.err
+}
+
+func renamePath(path []string, names map[string]string) (renamed []string) {
+	for _, p := range path {
+		renamed = append(renamed, names[p])
+	}
+	return
+}
+
+func (b *Body) Snapshot() (*Snapshot, error) {
+	// Hold the clock while the body is recorded, so that it is all recorded at
+	// the same time. A pause in the meantime outlasts the hold.
+	release := b.clock.Hold()
+	defer release()
+	status := b.clock.Status()
+	names := map[string]string{}
+	for _, node := range b.allNodes {
+		names[node.transportUrl] = node.name
+	}
+	dnas := &DNAIndex{}
+	snapshot := &Snapshot{
+		Seed:   Seed(),
+		Time:   b.clock.Now().UnixNano(),
+		Paused: status.Paused,
+		Speed:  status.Speed,
+	}
+	for _, organ := range ORGANS {
+		for _, node := range *b.OrganNodes(organ) {
+			nodeSnapshot := &NodeSnapshot{
+				Name:      node.name,
+				Organ:     organ,
+				Verbose:   node.verbose,
+				Materials: node.GetMaterialStatus(),
+				Cytokines: node.tissue.SnapshotCytokines(),
+			}
+			nodeSnapshot.ViralLoads, nodeSnapshot.AntibodyLoads = node.antigenPool.Snapshot(dnas)
+			for _, cell := range node.Cells() {
+				cellSnapshot := cell.Snapshot(dnas)
+				cellSnapshot.TransportPath = renamePath(cellSnapshot.TransportPath, names)
+				cellSnapshot.WantPath = renamePath(cellSnapshot.WantPath, names)
+				nodeSnapshot.Cells = append(nodeSnapshot.Cells, cellSnapshot)
+			}
+			snapshot.Nodes = append(snapshot.Nodes, nodeSnapshot)
+			node.RLock()
+			for _, edge := range node.edges {
+				if _, ok := names[edge.transportUrl]; !ok {
+					// Nodes hosted by other processes aren't part of the snapshot.
+					continue
+				}
+				snapshot.Edges = append(snapshot.Edges, &EdgeSnapshot{
+					From:     node.name,
+					To:       names[edge.transportUrl],
+					EdgeType: int32(edge.edgeType),
+				})
+			}
+			node.RUnlock()
+		}
+	}
+	if err := dnas.Err(); err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	snapshot.Dna = dnas.dna
+	snapshot.Lineage = b.lineage.Snapshot()
+	return snapshot, nil
+}
+
+func MakeMaterialSeed(status *MaterialStatusSocketData) *MaterialSeed {
+	return &MaterialSeed{
+		O2:             int(status.O2),
+		Glucose:        int(status.Glucose),
+		Vitamins:       int(status.Vitamin),
+		CO2:            int(status.Co2),
+		Creatinine:     int(status.Creatinine),
+		Growth:         int(status.Growth),
+		Hunger:         int(status.Hunger),
+		Asphyxia:       int(status.Asphyxia),
+		Inflammation:   int(status.Inflammation),
+		GranulocyteCSF: int(status.GCsf),
+		MacrophageCSF:  int(status.MCsf),
+		Interleukin3:   int(status.Il_3),
+		Interleukin2:   int(status.Il_2),
+		Ammonia:        int(status.Ammonia),
+		Glycogen:       int(status.Glycogen),
+		Complement:     int(status.Complement),
+		Insulin:        int(status.Insulin),
+		Glucagon:       int(status.Glucagon),
+		Interleukin10:  int(status.Il_10),
+	}
+}
+
+func (s *Snapshot) Validate() error {
+	names := map[string]bool{}
+	for _, node := range s.Nodes {
+		names[node.Name] = true
+		for _, cell := range node.Cells {
+			if _, err := ParseCellType(cell.CellType.String()); err != nil || cell.CellType == CellType_ViralLoadCarrier {
+				return fmt.Errorf("node %q has a cell of unknown type: %v", node.Name, cell.CellType)
+			}
+			if cell.Dna < 0 || int(cell.Dna) >= len(s.Dna) {
+				return fmt.Errorf("node %q has a cell with unknown DNA: %v", node.Name, cell.Dna)
+			}
+			if cell.ViralLoad != nil && (cell.ViralLoad.Dna < 0 || int(cell.ViralLoad.Dna) >= len(s.Dna)) {
+				return fmt.Errorf("node %q has a viral load with unknown DNA: %v", node.Name, cell.ViralLoad.Dna)
+			}
+		}
+		for _, viralLoad := range node.ViralLoads {
+			if viralLoad.Dna < 0 || int(viralLoad.Dna) >= len(s.Dna) {
+				return fmt.Errorf("node %q has a viral load with unknown DNA: %v", node.Name, viralLoad.Dna)
+			}
+		}
+	}
+	for _, edge := range s.Edges {
+		if !names[edge.From] || !names[edge.To] {
+			return fmt.Errorf("edge references unknown node: %q -> %q", edge.From, edge.To)
+		}
+	}
+	return nil
+}
+
+// RestoreBody rebuilds a body from a snapshot. The graph is built first, then
+// the pools and cytokines, and finally every cell is respawned where it was.
+func RestoreBody(ctx context.Context, snapshot *Snapshot, transport Transporter) (*Body, error) {
+	err := snapshot.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	anatomy := &Anatomy{}
+	for _, node := range snapshot.Nodes {
+		anatomy.Nodes = append(anatomy.Nodes, NodeSpec{
+			Name:    node.Name,
+			Organ:   node.Organ,
+			Verbose: node.Verbose,
+		})
+	}
+	err = anatomy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	dnas, err := ResolveDNA(snapshot.Dna)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+
+	SetSeed(snapshot.Seed)
+	b := InitializeBody(ctx, anatomy, transport)
+	b.clock.Pause()
+	b.clock.Set(time.Unix(0, snapshot.Time))
+	if snapshot.Speed > 0 {
+		b.clock.SetSpeed(snapshot.Speed)
+	}
+	b.lineage.Restore(snapshot.Lineage)
+	transportUrls := map[string]string{}
+	for _, node := range b.allNodes {
+		transportUrls[node.name] = node.transportUrl
+	}
+	for _, edge := range snapshot.Edges {
+		from, to := b.FindNode(edge.From), b.FindNode(edge.To)
+		err = from.Connect(ctx, to.transportUrl, EdgeType(edge.EdgeType))
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: %w", err)
+		}
+	}
+	for _, nodeSnapshot := range snapshot.Nodes {
+		node := b.FindNode(nodeSnapshot.Name)
+		if nodeSnapshot.Materials != nil {
+			node.materialPool.Seed(MakeMaterialSeed(nodeSnapshot.Materials))
+		}
+		for _, viralLoad := range nodeSnapshot.ViralLoads {
+			node.antigenPool.DepositViralLoad(&ViralLoad{
+				virus: &Virus{
+					dna:            dnas[viralLoad.Dna],
+					targetCellType: viralLoad.TargetCellType,
+					infectivity:    viralLoad.Infectivity,
+				},
+				concentration: viralLoad.Concentration,
+			})
+		}
+		for _, antibodyLoad := range nodeSnapshot.AntibodyLoads {
+			node.antigenPool.DepositAntibodyLoad(RestoreAntibodyLoad(antibodyLoad))
+		}
+		for _, cytokine := range nodeSnapshot.Cytokines {
+			node.tissue.RestoreCytokine(cytokine)
+		}
+		for _, cellSnapshot := range nodeSnapshot.Cells {
+			node.RestoreCell(ctx, cellSnapshot, dnas, transportUrls)
+		}
+	}
+	if !snapshot.Paused {
+		b.clock.Resume()
+	}
+	return b, nil
+}
+
+func (n *Node) RestoreCell(ctx context.Context, snapshot *CellSnapshot, dnas []*DNA, transportUrls map[string]string) CellActor {
+	cell := n.placeCell(snapshot, dnas, transportUrls)
+	ctx, stop := context.WithCancel(ctx)
+	cell.SetStop(stop)
+	cell.Start(ctx)
+	if n.verbose {
+		fmt.Println("Restored:", cell, "in", n)
+	}
+	return cell
+}
+
+// placeCell restores a cell into the node and its tissue without starting it,
+// so until it is started it only acts when something acts on it.
+func (n *Node) placeCell(snapshot *CellSnapshot, dnas []*DNA, transportUrls map[string]string) CellActor {
+	var transportPath, wantPath [10]string
+	for i := 0; i < len(snapshot.TransportPath) && i < len(transportPath); i++ {
+		transportPath[i] = transportUrls[snapshot.TransportPath[i]]
+	}
+	for i := 0; i < len(snapshot.WantPath) && i < len(wantPath); i++ {
+		wantPath[i] = transportUrls[snapshot.WantPath[i]]
+	}
+	var proteins []Protein
+	for _, p := range snapshot.Proteins {
+		proteins = append(proteins, Protein(p))
+	}
+	render := &Renderable{}
+	if snapshot.Position != nil {
+		render.position.X = int(snapshot.Position.X)
+		render.position.Y = int(snapshot.Position.Y)
+	}
+	cell := MakeCellFromType(snapshot.CellType, snapshot.WorkType, dnas[snapshot.Dna], render, time.Unix(0, snapshot.SpawnTime), transportPath, wantPath, proteins, MakeLockedRand(n.rand.Int63()))
+	cell.Restore(snapshot)
+	if cell.Lineage().ID == "" {
+		cell.SetLineage(Lineage{ID: MakeLineageId(cell.Rand())})
+	}
+	cell.SetOrgan(n)
+	n.AddCell(cell)
+	n.lineage.Arrive(cell, n)
+	ResumeCell(cell, snapshot, dnas)
+	if tissue := cell.Tissue(); tissue != nil {
+		tissue.Attach(cell.Render())
+	}
+	cell.SetStop(func() {})
+	return cell
+}
+
+// ResumeCell restores the state diagram of a cell before it starts: the viral
+// load, which grafts onto the diagram, and the diagram's cursor. Once started,
+// the diagram runs from there.
+func ResumeCell(cell CellActor, snapshot *CellSnapshot, dnas []*DNA) {
+	cell.MakeFunction(cell)
+	if snapshot.ViralLoad != nil {
+		virus := &Virus{
+			dna:            dnas[snapshot.ViralLoad.Dna],
+			targetCellType: snapshot.ViralLoad.TargetCellType,
+			infectivity:    snapshot.ViralLoad.Infectivity,
+		}
+		virus.Reinfect(cell)
+		cell.AddViralLoad(&ViralLoad{
+			virus:         virus,
+			concentration: snapshot.ViralLoad.Concentration,
+		})
+	}
+	if cell.Function() != nil {
+		cell.Function().SetCursor(int(snapshot.State))
+	}
+}
+
+func LoadSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	snapshot := &Snapshot{}
+	err = proto.Unmarshal(data, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+func SaveSnapshot(path string, snapshot *Snapshot) error {
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func (b *Body) HandleSnapshotRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Snapshots must be requested with GET.", http.StatusMethodNotAllowed)
+		return
+	}
+	snapshot, err := b.Snapshot()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Disposition", `attachment; filename="snapshot.pb"`)
+	w.Write(data)
+}
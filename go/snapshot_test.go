@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	humanDNA := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	dnas := &DNAIndex{}
+	dnas.Add(humanDNA)
+	spawnTime := time.Unix(1000, 0)
+	snapshot := &Snapshot{
+		Seed:   7,
+		Time:   spawnTime.Add(time.Hour).UnixNano(),
+		Paused: true,
+		Speed:  2,
+		Dna:    dnas.dna,
+		Nodes: []*NodeSnapshot{
+			{
+				Name:  "Snapshot Heart",
+				Organ: "heart",
+				Materials: &MaterialStatusSocketData{
+					O2:      12,
+					Glucose: 34,
+				},
+				AntibodyLoads: []*AntibodyLoadSnapshot{
+					{TargetProtein: 42, Concentration: 5},
+				},
+				Cytokines: []*CytokineSnapshot{
+					{Position: &Position{X: 1, Y: 2}, CytokineType: CytokineType_cell_damage, Concentration: 100, Radius: 3},
+				},
+				Cells: []*CellSnapshot{
+					{
+						CellType:      CellType_Cardiomyocyte,
+						WorkType:      WorkType_pump,
+						Dna:           0,
+						RenderId:      "Cardiomyocyte00000001",
+						Position:      &Position{X: 3, Y: 4},
+						Target:        &Position{X: 3, Y: 4},
+						Damage:        2,
+						SpawnTime:     spawnTime.UnixNano(),
+						TransportTime: spawnTime.UnixNano(),
+						TransportPath: []string{"", "", "", "", "", "", "", "", "Snapshot Lung", "Snapshot Heart"},
+						WantPath:      make([]string, 10),
+					},
+				},
+			},
+			{
+				Name:  "Snapshot Lung",
+				Organ: "lung",
+			},
+		},
+		Edges: []*EdgeSnapshot{
+			{From: "Snapshot Heart", To: "Snapshot Lung", EdgeType: int32(cardiovascular)},
+			{From: "Snapshot Lung", To: "Snapshot Heart", EdgeType: int32(cardiovascular)},
+		},
+	}
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := &Snapshot{}
+	if err := proto.Unmarshal(data, restored); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := RestoreBody(context.Background(), restored, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := body.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Time != snapshot.Time || !got.Paused || got.Speed != snapshot.Speed {
+		t.Errorf("clock = (%v, %v, %v), want (%v, true, %v)", got.Time, got.Paused, got.Speed, snapshot.Time, snapshot.Speed)
+	}
+	if len(got.Nodes) != 2 || len(got.Edges) != 2 {
+		t.Fatalf("got %v nodes and %v edges, want 2 and 2", len(got.Nodes), len(got.Edges))
+	}
+	heart := got.Nodes[0]
+	if heart.Name != "Snapshot Heart" {
+		heart = got.Nodes[1]
+	}
+	if heart.Materials.O2 != 12 || heart.Materials.Glucose != 34 {
+		t.Errorf("materials = %v, want o2 12 and glucose 34", heart.Materials)
+	}
+	if len(heart.AntibodyLoads) != 1 || heart.AntibodyLoads[0].Concentration != 5 {
+		t.Errorf("antibody loads = %v, want a single load of 5", heart.AntibodyLoads)
+	}
+	if len(heart.Cytokines) != 1 || heart.Cytokines[0].Concentration != 100 {
+		t.Errorf("cytokines = %v, want a single cytokine of 100", heart.Cytokines)
+	}
+	if len(heart.Cells) != 1 {
+		t.Fatalf("got %v cells, want 1", len(heart.Cells))
+	}
+	cell := heart.Cells[0]
+	want := snapshot.Nodes[0].Cells[0]
+	if cell.CellType != want.CellType || cell.RenderId != want.RenderId || cell.Damage != want.Damage || cell.SpawnTime != want.SpawnTime {
+		t.Errorf("cell = %v, want %v", cell, want)
+	}
+	if cell.TransportPath[8] != "Snapshot Lung" || cell.TransportPath[9] != "Snapshot Heart" {
+		t.Errorf("transport path = %v, want it to end in the lung then the heart", cell.TransportPath)
+	}
+	if len(got.Dna) != 1 || got.Dna[0].Name != HUMAN_NAME {
+		t.Errorf("got %v DNA, want only human DNA", len(got.Dna))
+	}
+}
+
+func TestInvalidSnapshot(t *testing.T) {
+	cases := []struct {
+		name     string
+		snapshot *Snapshot
+	}{
+		{"unknown edge node", &Snapshot{
+			Nodes: []*NodeSnapshot{{Name: "Heart", Organ: "heart"}},
+			Edges: []*EdgeSnapshot{{From: "Heart", To: "Brain"}},
+		}},
+		{"unknown DNA", &Snapshot{
+			Nodes: []*NodeSnapshot{{Name: "Heart", Organ: "heart", Cells: []*CellSnapshot{{CellType: CellType_Cardiomyocyte, Dna: 3}}}},
+		}},
+		{"unknown cell type", &Snapshot{
+			Dna:   []*DNASnapshot{{}},
+			Nodes: []*NodeSnapshot{{Name: "Heart", Organ: "heart", Cells: []*CellSnapshot{{CellType: CellType(1000)}}}},
+		}},
+	}
+	for _, c := range cases {
+		if _, err := RestoreBody(context.Background(), c.snapshot, InitializeMemoryTransport()); err == nil {
+			t.Errorf("%v: expected an error", c.name)
+		}
+	}
+}
@@ -21,8 +21,8 @@ import (
 
 type RenderID string
 
-func MakeRenderId(idPrefix string) RenderID {
-	return RenderID(fmt.Sprintf("%v%08v", idPrefix, rand.Intn(100000000)))
+func MakeRenderId(random *rand.Rand, idPrefix string) RenderID {
+	return RenderID(fmt.Sprintf("%v%08v", idPrefix, random.Intn(100000000)))
 }
 
 type Renderable struct {
@@ -71,10 +71,14 @@ type Tissue struct {
 	cellStreamingChan     chan chan *RenderableSocketData
 	cytokineStreamingChan chan chan *RenderableSocketData
 	rootMatrix            *ExtracellularMatrix
+	rand                  *rand.Rand
+	clock                 *Clock
 }
 
-func InitializeTissue(ctx context.Context) *Tissue {
+func InitializeTissue(ctx context.Context, random *rand.Rand, clock *Clock) *Tissue {
 	tissue := &Tissue{
+		rand:                  random,
+		clock:                 clock,
 		bounds:                image.Rect(-WORLD_BOUNDS/2, -WORLD_BOUNDS/2, WORLD_BOUNDS/2, WORLD_BOUNDS/2),
 		cellStreamingChan:     make(chan chan *RenderableSocketData, STREAMING_BUFFER_SIZE),
 		cytokineStreamingChan: make(chan chan *RenderableSocketData, STREAMING_BUFFER_SIZE),
@@ -93,7 +97,7 @@ func (t *Tissue) BuildTissue() {
 			prev:    curr,
 			next:    nil,
 			render: &Renderable{
-				id:       MakeRenderId("Matrix"),
+				id:       MakeRenderId(t.rand, "Matrix"),
 				visible:  true,
 				position: image.Point{0, 0},
 				targetX:  0,
@@ -125,13 +129,14 @@ func (t *Tissue) Detach(r *Renderable) {
 }
 
 func (t *Tissue) Start(ctx context.Context) {
-	ticker := time.NewTicker(CYTOKINE_TICK_RATE)
+	ticker := t.clock.NewTicker(ctx, CYTOKINE_TICK_RATE)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
-			t.Tick()
+		case now := <-ticker.C:
+			t.Tick(now)
 		case r := <-t.cellStreamingChan:
 			go t.rootMatrix.StartRenderCells(r)
 		case r := <-t.cytokineStreamingChan:
@@ -236,14 +241,51 @@ func (t *Tissue) RenderRootMatrix(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (t *Tissue) Tick() {
+func (t *Tissue) Tick(now time.Time) {
 	matrix := t.rootMatrix
 	for matrix != nil {
-		matrix.Tick()
+		matrix.Tick(now)
 		matrix = matrix.next
 	}
 }
 
+func (t *Tissue) SnapshotCytokines() (cytokines []*CytokineSnapshot) {
+	for matrix := t.rootMatrix; matrix != nil; matrix = matrix.next {
+		level := matrix.level
+		matrix.cytokinesMap.Range(func(_, cs any) bool {
+			cs.(*sync.Map).Range(func(_, c any) bool {
+				cytokine := c.(*Cytokine)
+				cytokine.RLock()
+				if cytokine.concentration > 0 {
+					cytokines = append(cytokines, &CytokineSnapshot{
+						Level:         int32(level),
+						Position:      &Position{X: int32(cytokine.center.X), Y: int32(cytokine.center.Y)},
+						CytokineType:  cytokine.cytokine,
+						Concentration: uint32(cytokine.concentration),
+						Radius:        int32(cytokine.radius),
+						LastTick:      cytokine.lastTick.UnixNano(),
+					})
+				}
+				cytokine.RUnlock()
+				return true
+			})
+			return true
+		})
+	}
+	return
+}
+
+func (t *Tissue) RestoreCytokine(snapshot *CytokineSnapshot) {
+	for matrix := t.rootMatrix; matrix != nil; matrix = matrix.next {
+		if matrix.level == int(snapshot.Level) && snapshot.Position != nil {
+			pt := image.Pt(int(snapshot.Position.X), int(snapshot.Position.Y))
+			cytokine := MakeCytokine(pt, snapshot.CytokineType, uint8(snapshot.Concentration), time.Unix(0, snapshot.LastTick))
+			cytokine.radius = int(snapshot.Radius)
+			matrix.GetCytokinesAtPoint(pt).Store(snapshot.CytokineType, cytokine)
+		}
+	}
+}
+
 func (t *Tissue) FindMatrix(r *Renderable) (m *ExtracellularMatrix) {
 	found := false
 	for m = t.rootMatrix; m != nil && !found; {
@@ -440,6 +482,8 @@ func (m *ExtracellularMatrix) GetCytokineColor(pt image.Point) color.Color {
 		CytokineType_antigen_present,
 		CytokineType_induce_chemotaxis,
 		CytokineType_cytotoxins,
+		CytokineType_anaphylatoxin,
+		CytokineType_anti_inflammatory,
 	}
 	concentrations := m.GetCytokineContentrations([]image.Point{pt}, cTypes)[0]
 	var hues []float64
@@ -461,6 +505,12 @@ func (m *ExtracellularMatrix) GetCytokineColor(pt image.Point) color.Color {
 			case CytokineType_induce_chemotaxis:
 				// Green.
 				h = float64(125) / float64(360)
+			case CytokineType_anaphylatoxin:
+				// Cyan.
+				h = float64(185) / float64(360)
+			case CytokineType_anti_inflammatory:
+				// Blue.
+				h = float64(225) / float64(360)
 			}
 			hues = append(hues, h)
 		}
@@ -617,10 +667,10 @@ func (m *ExtracellularMatrix) Detach(r *Renderable) {
 	delete(m.attached, r.id)
 }
 
-func (m *ExtracellularMatrix) Tick() {
+func (m *ExtracellularMatrix) Tick(now time.Time) {
 	m.cytokinesMap.Range(func(_, cytokines any) bool {
 		cytokines.(*sync.Map).Range(func(_, c any) bool {
-			c.(*Cytokine).Tick()
+			c.(*Cytokine).Tick(now)
 			return true
 		})
 		return true
@@ -707,6 +757,18 @@ func (m *ExtracellularMatrix) GetOpenSpaces(pts []image.Point) (open []image.Poi
 	return
 }
 
+// RandomOpenPoint picks an open point on the main stage, where cells are
+// placed, falling back to its center.
+func (m *ExtracellularMatrix) RandomOpenPoint(random *rand.Rand) image.Point {
+	for i := 0; i < OPEN_POINT_ATTEMPTS; i++ {
+		pt := m.walls.mainStage.center.Add(image.Point{RandInRange(random, -MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS), RandInRange(random, -MAIN_STAGE_RADIUS, MAIN_STAGE_RADIUS)})
+		if len(m.GetOpenSpaces([]image.Point{pt})) > 0 {
+			return pt
+		}
+	}
+	return m.walls.mainStage.center
+}
+
 func (m *ExtracellularMatrix) GetCytokineContentrations(pts []image.Point, types []CytokineType) (concentrations [][]uint8) {
 	for i := len(pts); i > 0; i-- {
 		concentrations = append(concentrations, make([]uint8, len(types)))
@@ -744,7 +806,7 @@ func (m *ExtracellularMatrix) AddCytokine(pt image.Point, t CytokineType, concen
 		return 0
 	}
 	cytokines := m.GetCytokinesAtPoint(pt)
-	c, _ := cytokines.LoadOrStore(t, MakeCytokine(pt, t, concentration))
+	c, _ := cytokines.LoadOrStore(t, MakeCytokine(pt, t, concentration, m.tissue.clock.Now()))
 	return c.(*Cytokine).Add(concentration)
 }
 
@@ -776,14 +838,15 @@ func (m *ExtracellularMatrix) ConsumeCytokines(pt image.Point, t CytokineType, c
 
 func (m *ExtracellularMatrix) GenerateWalls(numLines int, numBoxesPerLine int) *Walls {
 	bounds := m.tissue.bounds
+	random := m.tissue.rand
 	var boundaries []image.Rectangle
 	for i := 0; i < numLines; i++ {
-		p0 := MakeRandPoint(bounds)
+		p0 := MakeRandPoint(random, bounds)
 		for j := 0; j < numBoxesPerLine; j++ {
-			p1 := MakeRandPoint(bounds)
+			p1 := MakeRandPoint(random, bounds)
 			l := Line{p0, p1, LINE_WIDTH}
-			p2 := l.GetRandPoint()
-			boundary := MakeRandRect(bounds)
+			p2 := l.GetRandPoint(random)
+			boundary := MakeRandRect(random, bounds)
 			if !p2.In(boundary) {
 				boundary = boundary.Add(boundary.Min.Sub(p2)).Intersect(bounds)
 			}
@@ -811,11 +874,11 @@ func (m *ExtracellularMatrix) GenerateWalls(numLines int, numBoxesPerLine int) *
 			if minY > MAX_RADIUS {
 				minY = MAX_RADIUS
 			}
-			bubbles = append(bubbles, Circle{boundary.Min, RandInRange(2, minX)})
-			bubbles = append(bubbles, Circle{boundary.Max, RandInRange(2, minY)})
+			bubbles = append(bubbles, Circle{boundary.Min, RandInRange(random, 2, minX)})
+			bubbles = append(bubbles, Circle{boundary.Max, RandInRange(random, 2, minY)})
 		}
 	}
-	mainStage := Circle{image.Point{RandInRange(-5, 5), RandInRange(-5, 5)}, MAIN_STAGE_RADIUS}
+	mainStage := Circle{image.Point{RandInRange(random, -5, 5), RandInRange(random, -5, 5)}, MAIN_STAGE_RADIUS}
 	return &Walls{
 		mainStage:     mainStage,
 		boundaries:    finalBoundaries,
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+// Transporter carries cells, work and diffusion between nodes. HTTPTransport
+// gives every node its own server so nodes can run anywhere, while
+// MemoryTransport hands everything over directly within a single process.
+type Transporter interface {
+	// Listen assigns the node its address and makes it reachable there.
+	Listen(ctx context.Context, n *Node) error
+	SendCell(address string, request *TransportRequest) error
+	// SendControl hands an admin request to the node at address.
+	SendControl(address string, request *ControlRequest) error
+	// Dial opens a work connection from a node to the node at address.
+	Dial(ctx context.Context, from *Node, address string) (WorkConnection, error)
+	// Errors receives an error once a node stops being reachable, for the
+	// process to shut down on.
+	Errors() <-chan error
+}
+
+type WorkConnection interface {
+	SendWork(Work, *DiffusionSocketData) error
+	ReceiveWork() (Work, *DiffusionSocketData, error)
+	Address() string
+	Close() error
+}
+
+// HTTPTransport sends each DNA to a node in full once, and only its ID after
+// that.
+type HTTPTransport struct {
+	sync.Mutex
+	sent   map[string]map[string]bool // DNA IDs by address.
+	errors chan error
+	client *http.Client
+}
+
+func InitializeHTTPTransport() *HTTPTransport {
+	return &HTTPTransport{
+		client: &http.Client{Timeout: TRANSPORT_TIMEOUT},
+	}
+}
+
+func (t *HTTPTransport) Listen(ctx context.Context, n *Node) error {
+	n.origin, n.port, _, n.websocketUrl, n.transportUrl = GetNextAddresses()
+	n.serverMux = n.MakeServeMux(ctx)
+	// Bind before returning so edges can connect as soon as the node exists.
+	listener, err := net.Listen("tcp", n.port)
+	if err != nil {
+		return fmt.Errorf("%v Listen: %w", n, err)
+	}
+	server := &http.Server{Handler: n.serverMux}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.report(fmt.Errorf("%v Serve: %w", n, err))
+		}
+	}()
+	return nil
+}
+
+func (t *HTTPTransport) Errors() <-chan error {
+	return t.errorChan()
+}
+
+func (t *HTTPTransport) errorChan() chan error {
+	t.Lock()
+	defer t.Unlock()
+	if t.errors == nil {
+		t.errors = make(chan error, 1)
+	}
+	return t.errors
+}
+
+// report keeps the first error until it is received, since one is enough to
+// shut down on.
+func (t *HTTPTransport) report(err error) {
+	select {
+	case t.errorChan() <- err:
+	default:
+	}
+}
+
+func (t *HTTPTransport) SendCell(address string, request *TransportRequest) error {
+	status, err := t.sendCell(address, request)
+	if status == http.StatusConflict {
+		// The node doesn't have DNA it was sent before, so it was restarted or
+		// another process is listening there now.
+		t.Lock()
+		delete(t.sent, address)
+		t.Unlock()
+		_, err = t.sendCell(address, request)
+	}
+	return err
+}
+
+func (t *HTTPTransport) sendCell(address string, request *TransportRequest) (int, error) {
+	var unsent []int
+	t.Lock()
+	for i, d := range request.Dna {
+		if len(d.Base) == 0 && !t.sent[address][d.Id] {
+			unsent = append(unsent, i)
+		}
+	}
+	t.Unlock()
+	for _, i := range unsent {
+		dna := dnaRegistry.Find(request.Dna[i].Id)
+		if dna == nil {
+			return 0, fmt.Errorf("transport error: unknown DNA %v", request.Dna[i].Id)
+		}
+		full, err := dna.Snapshot()
+		if err != nil {
+			return 0, fmt.Errorf("transport error: %w", err)
+		}
+		request.Dna[i] = full
+	}
+	data, err := proto.Marshal(request)
+	if err != nil {
+		return 0, fmt.Errorf("transport error: %w", err)
+	}
+	httpRequest, err := http.NewRequest("POST", address, bytes.NewBuffer(data))
+	if err != nil {
+		return 0, fmt.Errorf("transport error: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-protobuf")
+
+	response, err := t.client.Do(httpRequest)
+	if err != nil {
+		return 0, fmt.Errorf("transport error: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(response.Body)
+		return response.StatusCode, fmt.Errorf("transport error: %v: %s", response.Status, bytes.TrimSpace(message))
+	}
+	t.Lock()
+	if t.sent == nil {
+		t.sent = map[string]map[string]bool{}
+	}
+	if t.sent[address] == nil {
+		t.sent[address] = map[string]bool{}
+	}
+	for _, i := range unsent {
+		t.sent[address][request.Dna[i].Id] = true
+	}
+	t.Unlock()
+	return response.StatusCode, nil
+}
+
+func (t *HTTPTransport) SendControl(address string, request *ControlRequest) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("control error: %w", err)
+	}
+	response, err := t.client.Post(ControlUrlFromTransportUrl(address), "application/json", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("control error: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("control error: %v: %s", response.Status, bytes.TrimSpace(message))
+	}
+	return nil
+}
+
+// The control endpoint is served alongside the transport endpoint.
+func ControlUrlFromTransportUrl(transportUrl string) string {
+	return strings.Replace(transportUrl, TRANSPORT_ENDPOINT, CONTROL_ENDPOINT, 1)
+}
+
+// The work socket is served alongside the transport endpoint.
+func WorkUrlFromTransportUrl(transportUrl string) string {
+	return strings.Replace(strings.Replace(transportUrl, TRANSPORT_ENDPOINT, WORK_ENDPOINT, 1), "http", "ws", 1)
+}
+
+func (t *HTTPTransport) Dial(ctx context.Context, from *Node, address string) (WorkConnection, error) {
+	dialer := websocket.Dialer{}
+	connection, response, err := dialer.DialContext(ctx, WorkUrlFromTransportUrl(address), http.Header{})
+	if err == nil && response.StatusCode != http.StatusSwitchingProtocols {
+		err = fmt.Errorf("unexpected status: %v", response.Status)
+	}
+	if err != nil {
+		return nil, &ConnectionError{Op: "dial", Address: address, Err: err}
+	}
+	return &Connection{
+		Conn: connection,
+	}, nil
+}
+
+type memoryNode struct {
+	ctx  context.Context
+	node *Node
+}
+
+type MemoryTransport struct {
+	sync.RWMutex
+	nodes map[string]memoryNode
+}
+
+func InitializeMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{
+		nodes: map[string]memoryNode{},
+	}
+}
+
+func (t *MemoryTransport) Listen(ctx context.Context, n *Node) error {
+	t.Lock()
+	defer t.Unlock()
+	n.transportUrl = fmt.Sprintf(MEMORY_URL_TEMPLATE, len(t.nodes))
+	t.nodes[n.transportUrl] = memoryNode{
+		ctx:  ctx,
+		node: n,
+	}
+	return nil
+}
+
+// Errors never receives, since nodes in memory can't become unreachable.
+func (t *MemoryTransport) Errors() <-chan error {
+	return nil
+}
+
+func (t *MemoryTransport) find(address string) (memoryNode, error) {
+	t.RLock()
+	defer t.RUnlock()
+	to, ok := t.nodes[address]
+	if !ok {
+		return to, fmt.Errorf("transport error: unknown node %v", address)
+	}
+	return to, nil
+}
+
+// SendCell hands the request over as is, since every DNA it references is in
+// the registry of this process.
+func (t *MemoryTransport) SendCell(address string, request *TransportRequest) error {
+	to, err := t.find(address)
+	if err != nil {
+		return err
+	}
+	_, err = to.node.ReceiveTransportRequest(to.ctx, request)
+	return err
+}
+
+func (t *MemoryTransport) SendControl(address string, request *ControlRequest) error {
+	to, err := t.find(address)
+	if err != nil {
+		return err
+	}
+	return to.node.Control(to.ctx, request)
+}
+
+func (t *MemoryTransport) Dial(ctx context.Context, from *Node, address string) (WorkConnection, error) {
+	to, err := t.find(address)
+	if err != nil {
+		return nil, &ConnectionError{Op: "dial", Address: address, Err: err}
+	}
+	local, remote := MakeMemoryConnections(from.transportUrl, address)
+	go to.node.ProcessIncomingWorkRequests(to.ctx, remote)
+	return local, nil
+}
+
+type memoryMessage struct {
+	work      Work
+	diffusion *DiffusionSocketData
+}
+
+// MemoryConnection is one end of an in-process work connection.
+type MemoryConnection struct {
+	address   string
+	in        <-chan memoryMessage
+	out       chan<- memoryMessage
+	closed    chan struct{}
+	closeOnce *sync.Once
+}
+
+// MakeMemoryConnections returns both ends of a work connection, the first for
+// the dialing node and the second for the node it dialed.
+func MakeMemoryConnections(fromAddress, toAddress string) (*MemoryConnection, *MemoryConnection) {
+	forward := make(chan memoryMessage, MEMORY_CONNECTION_BUFFER)
+	backward := make(chan memoryMessage, MEMORY_CONNECTION_BUFFER)
+	closed := make(chan struct{})
+	closeOnce := &sync.Once{}
+	return &MemoryConnection{
+		address:   toAddress,
+		in:        backward,
+		out:       forward,
+		closed:    closed,
+		closeOnce: closeOnce,
+	}, &MemoryConnection{
+		address:   fromAddress,
+		in:        forward,
+		out:       backward,
+		closed:    closed,
+		closeOnce: closeOnce,
+	}
+}
+
+func (c *MemoryConnection) SendWork(work Work, diffusion *DiffusionSocketData) error {
+	select {
+	case <-c.closed:
+		return &ConnectionError{Op: "send", Address: c.address, Err: net.ErrClosed}
+	case c.out <- memoryMessage{work, diffusion}:
+		return nil
+	}
+}
+
+func (c *MemoryConnection) ReceiveWork() (Work, *DiffusionSocketData, error) {
+	select {
+	case <-c.closed:
+		return Work{}, nil, &ConnectionError{Op: "receive", Address: c.address, Err: net.ErrClosed}
+	case message := <-c.in:
+		return message.work, message.diffusion, nil
+	}
+}
+
+func (c *MemoryConnection) Address() string {
+	return c.address
+}
+
+func (c *MemoryConnection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
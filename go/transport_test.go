@@ -0,0 +1,452 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type echoWorker struct {
+	workType WorkType
+}
+
+func (w *echoWorker) WorkType() WorkType   { return w.workType }
+func (w *echoWorker) SetOrgan(organ *Node) {}
+func (w *echoWorker) IsApoptosis() bool    { return false }
+func (w *echoWorker) Work(ctx context.Context, request Work) Work {
+	request.status = 200
+	request.result = "Completed."
+	return request
+}
+
+func TestMemoryTransportWork(t *testing.T) {
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: InitializeMemoryTransport(),
+	}
+	ctx := context.Background()
+	node1 := InitializeNewNode(ctx, testGraph, "node1", false)
+	node1.materialPool = nil
+	node2 := InitializeNewNode(ctx, testGraph, "node2", false)
+	node2.materialPool = nil
+	if node1.transportUrl == node2.transportUrl {
+		t.Fatalf("Expected nodes to have different addresses, got %v", node1.transportUrl)
+	}
+	if err := node1.Connect(ctx, node2.transportUrl, neuronal); err != nil {
+		t.Fatal(err)
+	}
+
+	testWorker := &echoWorker{workType: 6789}
+	node2.AddWorker(testWorker)
+	node2.MakeAvailable(ctx, testWorker)
+
+	result := node1.RequestWork(ctx, Work{
+		workType: 6789,
+	})
+	if result.status != 200 || result.result != "Completed." {
+		t.Errorf("Expected completed work from node2, got: %v", result)
+	}
+}
+
+func TestMemoryTransportUnknownNode(t *testing.T) {
+	transport := InitializeMemoryTransport()
+	node := &Node{name: "node1", transport: transport}
+	if err := transport.Listen(context.Background(), node); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Connect(context.Background(), "memory://nowhere", neuronal); err == nil {
+		t.Error("Expected an error connecting to an unknown node")
+	}
+	if err := transport.SendCell("memory://nowhere", &TransportRequest{}); err == nil {
+		t.Error("Expected an error sending a cell to an unknown node")
+	}
+}
+
+func TestEdgeReconnect(t *testing.T) {
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: InitializeMemoryTransport(),
+	}
+	ctx := context.Background()
+	node1 := InitializeNewNode(ctx, testGraph, "node1", false)
+	node1.materialPool = nil
+	node2 := InitializeNewNode(ctx, testGraph, "node2", false)
+	node2.materialPool = nil
+	if err := node1.Connect(ctx, node2.transportUrl, neuronal); err != nil {
+		t.Fatal(err)
+	}
+	testWorker := &echoWorker{workType: 6789}
+	node2.AddWorker(testWorker)
+
+	edge := node1.edges[0]
+	edge.RLock()
+	broken := edge.workConnection
+	edge.RUnlock()
+	// Work sent the wrong way round is dropped, without closing the connection.
+	if err := edge.SendWork(Work{workType: 6789, status: 200}, nil); err != nil {
+		t.Fatal(err)
+	}
+	broken.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		edge.RLock()
+		reconnected := edge.healthy && edge.workConnection != broken
+		edge.RUnlock()
+		if reconnected {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the edge to reconnect")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	node2.MakeAvailable(ctx, testWorker)
+	result := node1.RequestWork(ctx, Work{
+		workType: 6789,
+	})
+	if result.status != 200 {
+		t.Errorf("Expected completed work over the new connection, got: %v", result)
+	}
+}
+
+func TestMalformedTransportRequest(t *testing.T) {
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: InitializeMemoryTransport(),
+	}
+	ctx := context.Background()
+	node := InitializeNewNode(ctx, testGraph, "node1", false)
+	human, err := MakeDNA(HUMAN_DNA, HUMAN_NAME).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		request *TransportRequest
+		body    string
+		code    int
+	}{
+		{name: "garbage", body: "\xff\xff", code: http.StatusBadRequest},
+		{name: "no cell", request: &TransportRequest{}, code: http.StatusBadRequest},
+		{name: "bad DNA", request: &TransportRequest{
+			Cell: &CellSnapshot{CellType: CellType_Myocyte},
+			Dna:  []*DNASnapshot{{Name: HUMAN_NAME, DnaType: 521, Base: []byte("AAAA")}},
+		}, code: http.StatusBadRequest},
+		{name: "DNA not matching its ID", request: &TransportRequest{
+			Cell: &CellSnapshot{CellType: CellType_Myocyte},
+			Dna:  []*DNASnapshot{{Id: "0123", Name: human.Name, DnaType: human.DnaType, Base: human.Base}},
+		}, code: http.StatusBadRequest},
+		{name: "unknown cell type", request: &TransportRequest{
+			Cell: &CellSnapshot{CellType: 1000},
+			Dna:  []*DNASnapshot{human},
+		}, code: http.StatusBadRequest},
+		{name: "missing DNA", request: &TransportRequest{
+			Cell: &CellSnapshot{CellType: CellType_Myocyte, Dna: 1},
+			Dna:  []*DNASnapshot{human},
+		}, code: http.StatusBadRequest},
+		{name: "unknown DNA ID", request: &TransportRequest{
+			Cell: &CellSnapshot{CellType: CellType_Myocyte},
+			Dna:  []*DNASnapshot{{Id: "0123"}},
+		}, code: http.StatusConflict},
+	}
+	for _, c := range cases {
+		body := []byte(c.body)
+		if c.request != nil {
+			body, err = proto.Marshal(c.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		w := httptest.NewRecorder()
+		node.HandleTransportRequest(ctx, w, httptest.NewRequest(http.MethodPost, TRANSPORT_ENDPOINT, bytes.NewReader(body)))
+		if w.Code != c.code {
+			t.Errorf("%v: expected a %v, got: %v", c.name, c.code, w.Code)
+		}
+	}
+	if len(node.Cells()) != 0 {
+		t.Errorf("Expected no cells, got: %v", node.Cells())
+	}
+}
+
+func TestTransportCellState(t *testing.T) {
+	ctx := context.Background()
+	body, err := RestoreBody(ctx, &Snapshot{
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Nodes:  []*NodeSnapshot{{Name: "Transport Lung", Organ: "lung"}},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lung := body.FindNode("Transport Lung")
+	var requests []*TransportRequest
+	conflict := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		request := &TransportRequest{}
+		proto.Unmarshal(data, request)
+		requests = append(requests, request)
+		if conflict && len(request.Dna[0].Base) == 0 {
+			// Pretend the node was restarted.
+			http.Error(w, "unknown DNA", http.StatusConflict)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(data))
+		lung.HandleTransportRequest(ctx, w, r)
+	}))
+	defer server.Close()
+	sender := &Node{name: "Transport Heart", transport: InitializeHTTPTransport()}
+
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	virus := &Virus{
+		dna:            MakeDNA(VIRUS_RNA, "Transport Virus"),
+		targetCellType: CellType_Pneumocyte,
+		infectivity:    10,
+	}
+	transport := func() CellActor {
+		cell := MakeCellFromType(CellType_Pneumocyte, WorkType_exhale, human, &Renderable{}, body.clock.Now(), [10]string{}, [10]string{}, nil, unownedRand)
+		cell.Restore(&CellSnapshot{
+			Damage:       7,
+			LineageId:    MakeLineageId(unownedRand),
+			AntibodyLoad: &AntibodyLoadSnapshot{TargetProtein: 42, Concentration: 3},
+		})
+		cell.AddViralLoad(&ViralLoad{virus: virus, concentration: 50})
+		cell.MakeFunction(cell).SetCursor(2)
+		if err := sender.TransportCell(server.URL, cell); err != nil {
+			t.Fatal(err)
+		}
+		return cell
+	}
+
+	sent := transport()
+	cells := lung.Cells()
+	if len(cells) != 1 {
+		t.Fatalf("Expected the cell to arrive, got %v cells", len(cells))
+	}
+	arrived := cells[0]
+	if arrived.Damage() != 7 || arrived.Lineage().ID != sent.Lineage().ID {
+		t.Errorf("Expected the damage and lineage to carry over, got: %v, %v", arrived.Damage(), arrived.Lineage())
+	}
+	if arrived.ViralLoad() == nil || arrived.ViralLoad().concentration != 50 || arrived.ViralLoad().virus.dna != virus.dna {
+		t.Errorf("Expected the cell to stay infected, got: %+v", arrived.ViralLoad())
+	}
+	if arrived.AntibodyLoad() == nil || arrived.AntibodyLoad().concentration != 3 {
+		t.Errorf("Expected the antibodies to carry over, got: %+v", arrived.AntibodyLoad())
+	}
+	// The diagram is resumed before the cell starts running it.
+	if arrived.Function() == nil || arrived.Function().Cursor() != 2 {
+		t.Errorf("Expected the cell to resume its state diagram, got: %+v", arrived.Function())
+	}
+
+	transport()
+	if len(requests) != 2 || len(requests[0].Dna) != 2 || len(requests[0].Dna[0].Base) == 0 {
+		t.Fatalf("Expected the DNA to be sent in full the first time, got: %v", requests)
+	}
+	for _, dna := range requests[1].Dna {
+		if dna.Id == "" || len(dna.Base) > 0 {
+			t.Errorf("Expected the DNA to be sent by ID the second time, got: %v", dna)
+		}
+	}
+
+	conflict = true
+	transport()
+	if len(requests) != 4 || len(requests[3].Dna[0].Base) == 0 || len(lung.Cells()) != 3 {
+		t.Errorf("Expected the DNA to be sent in full again after a conflict, got: %v", requests[2:])
+	}
+}
+
+func TestTransportCellHistory(t *testing.T) {
+	ctx := context.Background()
+	body, err := RestoreBody(ctx, &Snapshot{
+		Time:   time.Unix(1000, 0).UnixNano(),
+		Paused: true,
+		Nodes:  []*NodeSnapshot{{Name: "History Blood", Organ: "blood"}},
+	}, InitializeMemoryTransport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blood := body.FindNode("History Blood")
+	sender := &Node{name: "History Lung", transport: blood.transport}
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	activated := body.clock.Now().Add(-time.Second)
+
+	transport := func(cellType CellType, snapshot *CellSnapshot) CellActor {
+		cell := MakeCellFromType(cellType, WorkType_nothing, human, &Renderable{}, body.clock.Now(), [10]string{}, [10]string{}, []Protein{1, 2, 3}, unownedRand)
+		snapshot.LineageId = MakeLineageId(unownedRand)
+		cell.Restore(snapshot)
+		if err := sender.TransportCell(blood.transportUrl, cell); err != nil {
+			t.Fatal(err)
+		}
+		for _, arrived := range blood.Cells() {
+			if arrived.Lineage().ID == snapshot.LineageId {
+				return arrived
+			}
+		}
+		t.Fatalf("Expected a %v to arrive, got: %v", cellType, blood.Cells())
+		return nil
+	}
+
+	macrophage := transport(CellType_Macrophagocyte, &CellSnapshot{ActivationTime: activated.UnixNano()}).(*Macrophage)
+	if !macrophage.activationTime.Equal(activated) || !macrophage.IsActivated() {
+		t.Errorf("Expected the macrophage to stay activated, got: %v", macrophage.activationTime)
+	}
+	neutrophil := transport(CellType_Neutrocyte, &CellSnapshot{InNetosis: true}).(*Neutrophil)
+	if !neutrophil.inNETosis {
+		t.Error("Expected the neutrophil to stay in NETosis")
+	}
+	dendritic := transport(CellType_Dendritic, &CellSnapshot{Presented: []uint32{2}}).(*DendriticCell)
+	if presented := dendritic.mhc_ii.GetPresented(); len(presented) != 1 || presented[0] != 2 {
+		t.Errorf("Expected the dendritic cell to keep presenting, got: %v", presented)
+	}
+	redBlood := transport(CellType_RedBlood, &CellSnapshot{
+		Oxygenated:   true,
+		CellActions:  []CellActionStatus{CellActionStatus_do_work, CellActionStatus_repair},
+		ResourceNeed: &ResourceBlobSocketData{O2: 4, Glucose: 5},
+	})
+	if !redBlood.IsOxygenated() {
+		t.Error("Expected the red blood cell to stay oxygenated")
+	}
+	actions := redBlood.(*EukaryoticCell).CellActions()
+	expected := []CellActionStatus{CellActionStatus_do_work, CellActionStatus_repair, CellActionStatus_transport}
+	if len(actions) != len(expected) {
+		t.Fatalf("Expected the actions %v, got: %v", expected, actions)
+	}
+	for i := range expected {
+		if actions[i] != expected[i] {
+			t.Errorf("Expected the actions %v, got: %v", expected, actions)
+		}
+	}
+	if need := redBlood.(*EukaryoticCell).resourceNeed; need == nil || need.o2 != 4 || need.glucose != 5 {
+		t.Errorf("Expected the resource need to carry over, got: %+v", need)
+	}
+}
+
+func TestSeededTransportRequest(t *testing.T) {
+	// The nodes' pools panic once their context is canceled, so it never is.
+	ctx := context.Background()
+	human := MakeDNA(HUMAN_DNA, HUMAN_NAME)
+	makeNode := func() *Node {
+		// Seed 0 is as valid as any other.
+		SetSeed(0)
+		return InitializeNewNode(ctx, &Graph{
+			allNodes:  make(map[string]*Node),
+			clock:     InitializeClock(),
+			transport: InitializeMemoryTransport(),
+		}, "Seeded Bone", false)
+	}
+	quiet, busy := makeNode(), makeNode()
+	// Diffusion and exposures draw from streams of their own, and other
+	// requests from the cells stream, so neither changes a seeded spawn.
+	busy.diffusionRand.Int63()
+	busy.exposureRand.Int63()
+	if quiet.rand.Int63() != busy.rand.Int63() {
+		t.Errorf("Expected the node's cells stream not to depend on its other streams")
+	}
+	busy.rand.Int63()
+
+	spawn := func(node *Node) CellActor {
+		request := MakeSpawnRequest(HUMAN_NAME, human, CellType_Neutrocyte, WorkType_nothing, "", time.Unix(1000, 0), [10]string{}, [10]string{}, nil, Lineage{}, 1234)
+		cell, err := node.MakeCellFromRequest(request, []*DNA{human})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cell
+	}
+	a, b := spawn(quiet), spawn(busy)
+	if a.Render().id != b.Render().id || a.Render().position != b.Render().position {
+		t.Errorf("Expected the same seed to render the same, got: %v at %v, %v at %v", a.Render().id, a.Render().position, b.Render().id, b.Render().position)
+	}
+	if a.Lineage().ID != b.Lineage().ID {
+		t.Errorf("Expected the same seed to make the same lineage, got: %v, %v", a.Lineage(), b.Lineage())
+	}
+	if a.Rand().Int63() != b.Rand().Int63() {
+		t.Errorf("Expected the same seed to give the cells the same stream")
+	}
+}
+
+func TestHTTPTransportShutdown(t *testing.T) {
+	transport := InitializeHTTPTransport()
+	testGraph := &Graph{
+		allNodes:  make(map[string]*Node),
+		clock:     InitializeClock(),
+		transport: transport,
+	}
+	node := InitializeNewNode(context.Background(), testGraph, "Shutdown Heart", false)
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := transport.Listen(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+	dial := func() error {
+		conn, err := net.Dial("tcp", "localhost"+node.port)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+	if err := dial(); err != nil {
+		t.Fatalf("Expected the node to be reachable, got: %v", err)
+	}
+	// Closing the server with the context isn't an error to shut down on.
+	cancel()
+	for i := 0; ; i++ {
+		if dial() != nil {
+			break
+		}
+		if i == 100 {
+			t.Fatal("Expected the node to stop listening")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-transport.Errors():
+		t.Errorf("Expected no error once the context is done, got: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHTTPTransportControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testGraph := &Graph{
+		allNodes: make(map[string]*Node),
+		clock:    InitializeClock(),
+	}
+	node := InitializeNewNode(ctx, testGraph, "Control Lung", false)
+	server := httptest.NewServer(node.MakeServeMux(ctx))
+	defer server.Close()
+	address := server.URL + TRANSPORT_ENDPOINT
+	transport := InitializeHTTPTransport()
+
+	status := ClockStatus{Now: time.Now().Add(time.Hour), Paused: true, Speed: 2}
+	if err := transport.SendControl(address, &ControlRequest{Clock: &status}); err == nil {
+		t.Error("Expected an error controlling a node outside of a body")
+	}
+	var received *ControlRequest
+	node.control = func(ctx context.Context, request *ControlRequest) error {
+		received = request
+		return testGraph.clock.Sync(*request.Clock)
+	}
+	if err := transport.SendControl(address, &ControlRequest{Clock: &status}); err != nil {
+		t.Fatal(err)
+	}
+	if received == nil || received.Exposure != nil {
+		t.Fatalf("Expected only the clock to be sent, got: %+v", received)
+	}
+	if now := testGraph.clock.Status(); !now.Now.Equal(status.Now) || !now.Paused || now.Speed != 2 {
+		t.Errorf("Expected the clock to be synced, got: %+v", now)
+	}
+	if err := transport.SendControl(address, &ControlRequest{Clock: &ClockStatus{}}); err == nil {
+		t.Error("Expected an error syncing to a clock without a speed")
+	}
+}
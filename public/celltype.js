@@ -41,6 +41,14 @@ proto.efflux.CellType = {
   KILLERTLYMPHOCYTE: 21,
   BLYMPHOCYTE: 22,
   EFFECTORBLYMPHOCYTE: 23,
-  VIRALLOADCARRIER: 24
+  VIRALLOADCARRIER: 24,
+  HEPATOCYTE: 25,
+  THYMICEPITHELIAL: 26,
+  THYMOCYTE: 27,
+  PANCREATICBETA: 28,
+  PANCREATICALPHA: 29,
+  MEMORYTLYMPHOCYTE: 30,
+  MEMORYBLYMPHOCYTE: 31,
+  REGULATORYTLYMPHOCYTE: 32
 };
 
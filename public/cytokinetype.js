@@ -22,6 +22,8 @@ proto.efflux.CytokineType = {
   CELL_STRESSED: 2,
   ANTIGEN_PRESENT: 3,
   INDUCE_CHEMOTAXIS: 4,
-  CYTOTOXINS: 5
+  CYTOTOXINS: 5,
+  ANAPHYLATOXIN: 6,
+  ANTI_INFLAMMATORY: 7
 };
 
@@ -73,7 +73,11 @@ proto.efflux.HormoneBlobSocketData.toObject = function(includeInstance, msg) {
     granulocyteColonyStimulatingFactor: jspb.Message.getFieldWithDefault(msg, 1, 0),
     macrophageColonyStimulatingFactor: jspb.Message.getFieldWithDefault(msg, 2, 0),
     interleukin3: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    interleukin2: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    interleukin2: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    complement: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    insulin: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    glucagon: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    interleukin10: jspb.Message.getFieldWithDefault(msg, 8, 0)
   };
 
   if (includeInstance) {
@@ -126,6 +130,22 @@ proto.efflux.HormoneBlobSocketData.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {number} */ (reader.readInt32());
       msg.setInterleukin2(value);
       break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setComplement(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInsulin(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGlucagon(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInterleukin10(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -183,6 +203,34 @@ proto.efflux.HormoneBlobSocketData.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getComplement();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getInsulin();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getGlucagon();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getInterleukin10();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
 };
 
 
@@ -258,3 +306,75 @@ proto.efflux.HormoneBlobSocketData.prototype.setInterleukin2 = function(value) {
 };
 
 
+/**
+ * optional int32 complement = 5;
+ * @return {number}
+ */
+proto.efflux.HormoneBlobSocketData.prototype.getComplement = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.HormoneBlobSocketData} returns this
+ */
+proto.efflux.HormoneBlobSocketData.prototype.setComplement = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int32 insulin = 6;
+ * @return {number}
+ */
+proto.efflux.HormoneBlobSocketData.prototype.getInsulin = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.HormoneBlobSocketData} returns this
+ */
+proto.efflux.HormoneBlobSocketData.prototype.setInsulin = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 glucagon = 7;
+ * @return {number}
+ */
+proto.efflux.HormoneBlobSocketData.prototype.getGlucagon = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.HormoneBlobSocketData} returns this
+ */
+proto.efflux.HormoneBlobSocketData.prototype.setGlucagon = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 interleukin10 = 8;
+ * @return {number}
+ */
+proto.efflux.HormoneBlobSocketData.prototype.getInterleukin10 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.HormoneBlobSocketData} returns this
+ */
+proto.efflux.HormoneBlobSocketData.prototype.setInterleukin10 = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
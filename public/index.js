@@ -863,16 +863,24 @@ function getColor(type) {
                 return 'yellow';
             case proto.efflux.CellType.NATURALKILLERCELL:
                 return 'lime';
+            case proto.efflux.CellType.THYMOCYTE:
+                return 'paleturquoise';
             case proto.efflux.CellType.VIRGINTLYMPHOCYTE:
                 return 'turquoise';
             case proto.efflux.CellType.HELPERTLYMPHOCYTE:
                 return 'mediumseagreen';
             case proto.efflux.CellType.KILLERTLYMPHOCYTE:
                 return 'seagreen';
+            case proto.efflux.CellType.MEMORYTLYMPHOCYTE:
+                return 'darkturquoise';
+            case proto.efflux.CellType.REGULATORYTLYMPHOCYTE:
+                return 'aquamarine';
             case proto.efflux.CellType.BLYMPHOCYTE:
                 return 'lightsalmon';
             case proto.efflux.CellType.EFFECTORBLYMPHOCYTE:
                 return 'salmon';
+            case proto.efflux.CellType.MEMORYBLYMPHOCYTE:
+                return 'darksalmon';
             case proto.efflux.CellType.REDBLOOD:
             case proto.efflux.CellType.NEURON:
             case proto.efflux.CellType.CARDIOMYOCYTE:
@@ -881,6 +889,10 @@ function getColor(type) {
             case proto.efflux.CellType.KERATINOCYTE:
             case proto.efflux.CellType.ENTEROCYTE:
             case proto.efflux.CellType.PODOCYTE:
+            case proto.efflux.CellType.HEPATOCYTE:
+            case proto.efflux.CellType.THYMICEPITHELIAL:
+            case proto.efflux.CellType.PANCREATICBETA:
+            case proto.efflux.CellType.PANCREATICALPHA:
             case proto.efflux.CellType.HEMOCYTOBLAST:
             default:
                 return 'red';
@@ -897,6 +909,10 @@ function getColor(type) {
                 return 'green';
             case proto.efflux.CytokineType.CYTOTOXINS:
                 return 'purple';
+            case proto.efflux.CytokineType.ANAPHYLATOXIN:
+                return 'cyan';
+            case proto.efflux.CytokineType.ANTI_INFLAMMATORY:
+                return 'blue';
             default:
                 return 'white';
         }
@@ -84,7 +84,13 @@ proto.efflux.MaterialStatusSocketData.toObject = function(includeInstance, msg)
     il3: jspb.Message.getFieldWithDefault(msg, 12, 0),
     il2: jspb.Message.getFieldWithDefault(msg, 13, 0),
     viralLoad: jspb.Message.getFieldWithDefault(msg, 14, 0),
-    antibodyLoad: jspb.Message.getFieldWithDefault(msg, 15, 0)
+    antibodyLoad: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    ammonia: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    glycogen: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    complement: jspb.Message.getFieldWithDefault(msg, 18, 0),
+    insulin: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    glucagon: jspb.Message.getFieldWithDefault(msg, 20, 0),
+    il10: jspb.Message.getFieldWithDefault(msg, 21, 0)
   };
 
   if (includeInstance) {
@@ -181,6 +187,30 @@ proto.efflux.MaterialStatusSocketData.deserializeBinaryFromReader = function(msg
       var value = /** @type {number} */ (reader.readInt32());
       msg.setAntibodyLoad(value);
       break;
+    case 16:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAmmonia(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGlycogen(value);
+      break;
+    case 18:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setComplement(value);
+      break;
+    case 19:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInsulin(value);
+      break;
+    case 20:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setGlucagon(value);
+      break;
+    case 21:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setIl10(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -315,6 +345,48 @@ proto.efflux.MaterialStatusSocketData.serializeBinaryToWriter = function(message
       f
     );
   }
+  f = message.getAmmonia();
+  if (f !== 0) {
+    writer.writeInt32(
+      16,
+      f
+    );
+  }
+  f = message.getGlycogen();
+  if (f !== 0) {
+    writer.writeInt32(
+      17,
+      f
+    );
+  }
+  f = message.getComplement();
+  if (f !== 0) {
+    writer.writeInt32(
+      18,
+      f
+    );
+  }
+  f = message.getInsulin();
+  if (f !== 0) {
+    writer.writeInt32(
+      19,
+      f
+    );
+  }
+  f = message.getGlucagon();
+  if (f !== 0) {
+    writer.writeInt32(
+      20,
+      f
+    );
+  }
+  f = message.getIl10();
+  if (f !== 0) {
+    writer.writeInt32(
+      21,
+      f
+    );
+  }
 };
 
 
@@ -588,3 +660,111 @@ proto.efflux.MaterialStatusSocketData.prototype.setAntibodyLoad = function(value
 };
 
 
+/**
+ * optional int32 ammonia = 16;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getAmmonia = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setAmmonia = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional int32 glycogen = 17;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getGlycogen = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setGlycogen = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * optional int32 complement = 18;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getComplement = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 18, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setComplement = function(value) {
+  return jspb.Message.setProto3IntField(this, 18, value);
+};
+
+
+/**
+ * optional int32 insulin = 19;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getInsulin = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setInsulin = function(value) {
+  return jspb.Message.setProto3IntField(this, 19, value);
+};
+
+
+/**
+ * optional int32 glucagon = 20;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getGlucagon = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 20, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setGlucagon = function(value) {
+  return jspb.Message.setProto3IntField(this, 20, value);
+};
+
+
+/**
+ * optional int32 il_10 = 21;
+ * @return {number}
+ */
+proto.efflux.MaterialStatusSocketData.prototype.getIl10 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 21, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.MaterialStatusSocketData} returns this
+ */
+proto.efflux.MaterialStatusSocketData.prototype.setIl10 = function(value) {
+  return jspb.Message.setProto3IntField(this, 21, value);
+};
+
+
@@ -71,7 +71,8 @@ proto.efflux.WasteBlobSocketData.prototype.toObject = function(opt_includeInstan
 proto.efflux.WasteBlobSocketData.toObject = function(includeInstance, msg) {
   var f, obj = {
     cO2: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    creatinine: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    creatinine: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    ammonia: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -116,6 +117,10 @@ proto.efflux.WasteBlobSocketData.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {number} */ (reader.readInt32());
       msg.setCreatinine(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAmmonia(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -159,6 +164,13 @@ proto.efflux.WasteBlobSocketData.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getAmmonia();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -198,3 +210,21 @@ proto.efflux.WasteBlobSocketData.prototype.setCreatinine = function(value) {
 };
 
 
+/**
+ * optional int32 ammonia = 3;
+ * @return {number}
+ */
+proto.efflux.WasteBlobSocketData.prototype.getAmmonia = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.efflux.WasteBlobSocketData} returns this
+ */
+proto.efflux.WasteBlobSocketData.prototype.setAmmonia = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
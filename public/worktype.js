@@ -26,6 +26,10 @@ proto.efflux.WorkType = {
   MOVE: 6,
   THINK: 7,
   DIGEST: 8,
-  FILTER: 9
+  FILTER: 9,
+  GLYCOGENESIS: 10,
+  GLYCOGENOLYSIS: 11,
+  DETOXIFY: 12,
+  COMPLEMENT: 13
 };
 